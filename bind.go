@@ -0,0 +1,267 @@
+package nsql
+
+import (
+	"database/sql/driver"
+	"github.com/nbs-go/nsql/op"
+	"github.com/nbs-go/nsql/schema"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Placeholder returns n-th positional placeholder of variable format, e.g. $1 for op.DollarVar and @p1 for op.AtPVar.
+// Other formats are written as ? bind variable
+func Placeholder(format op.VariableFormat, n int) string {
+	switch format {
+	case op.DollarVar:
+		return "$" + strconv.Itoa(n)
+	case op.AtPVar:
+		return "@p" + strconv.Itoa(n)
+	}
+	return "?"
+}
+
+// WritePlaceholder write n-th positional placeholder of variable format into sb
+func WritePlaceholder(sb *strings.Builder, format op.VariableFormat, n int) {
+	switch format {
+	case op.DollarVar:
+		sb.WriteByte('$')
+	case op.AtPVar:
+		sb.WriteString("@p")
+	default:
+		sb.WriteByte('?')
+		return
+	}
+	WriteInt(sb, int64(n))
+}
+
+// WriteInt write decimal integer into sb without allocating intermediate string
+func WriteInt(sb *strings.Builder, n int64) {
+	var buf [20]byte
+	sb.Write(strconv.AppendInt(buf[:0], n, 10))
+}
+
+// Rebind replace ? bind variables in query to placeholders of variable format. Only op.DollarVar and op.AtPVar are rewritten to
+// positional placeholders, other format will return query as is. Bind variables in quoted identifiers and string literals are kept
+func Rebind(format op.VariableFormat, q string) string {
+	if (format != op.DollarVar && format != op.AtPVar) || !strings.Contains(q, "?") {
+		return q
+	}
+
+	var b strings.Builder
+	b.Grow(len(q) + 8)
+
+	n := 0
+	for i := 0; i < len(q); i++ {
+		switch c := q[i]; {
+		case isQuote(format, c):
+			end := quotedEnd(q, i)
+			b.WriteString(q[i:end])
+			i = end - 1
+		case c == '?':
+			n++
+			WritePlaceholder(&b, format, n)
+		default:
+			b.WriteByte(c)
+		}
+	}
+
+	return b.String()
+}
+
+// BindNamed convert :name variables in query to ? bind variables and returns values in order of placeholders. Values
+// are retrieved from arg that can be a map with string key, or a struct that its fields are mapped to columns with the
+// same rules as schema.FromModelRef. If value is a slice, then variable is expanded to bind variables of each item, so
+// it can be used in IN condition
+func BindNamed(q string, arg interface{}) (string, []interface{}, error) {
+	return BindNamedFormat(op.BindVar, q, arg)
+}
+
+// BindNamedFormat convert :name variables in query to placeholders of variable format. See BindNamed
+func BindNamedFormat(format op.VariableFormat, q string, arg interface{}) (string, []interface{}, error) {
+	if format != op.BindVar && format != op.DollarVar && format != op.AtPVar {
+		return "", nil, NewBuildError(ErrInvalidArgument, "nsql: unsupported variable format for binding named variables")
+	}
+
+	// Init value resolver
+	valueOf, err := newNamedValueResolver(arg)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var b strings.Builder
+	b.Grow(len(q))
+	var args []interface{}
+
+	for i := 0; i < len(q); i++ {
+		c := q[i]
+		switch {
+		case isQuote(format, c):
+			end := quotedEnd(q, i)
+			b.WriteString(q[i:end])
+			i = end - 1
+			continue
+		case c != ':':
+			b.WriteByte(c)
+			continue
+		case i+1 < len(q) && q[i+1] == ':':
+			// Write type cast operator, e.g. ::text
+			b.WriteString("::")
+			i++
+			continue
+		case i+1 >= len(q) || !isNameStart(q[i+1]):
+			b.WriteByte(c)
+			continue
+		}
+
+		// Get name
+		end := i + 1
+		for end < len(q) && isNameChar(q[end]) {
+			end++
+		}
+		name := q[i+1 : end]
+		i = end - 1
+
+		// Resolve value
+		v, ok := valueOf(name)
+		if !ok {
+			return "", nil, NewBuildError(ErrNoArguments, `nsql: value of named variable "%s" is not found`, name)
+		}
+
+		// Expand slice value
+		values, expanded := expandValue(v)
+		if expanded && len(values) == 0 {
+			return "", nil, NewBuildError(ErrNoArguments, `nsql: named variable "%s" is bound to an empty slice`, name)
+		}
+
+		// Write placeholders
+		for j, ev := range values {
+			if j > 0 {
+				b.WriteString(Separator)
+			}
+			args = append(args, ev)
+			WritePlaceholder(&b, format, len(args))
+		}
+	}
+
+	return b.String(), args, nil
+}
+
+// newNamedValueResolver create a function that returns value by name from map or struct
+func newNamedValueResolver(arg interface{}) (func(name string) (interface{}, bool), error) {
+	if m, ok := arg.(map[string]interface{}); ok {
+		return func(name string) (interface{}, bool) {
+			v, vOk := m[name]
+			return v, vOk
+		}, nil
+	}
+
+	// Resolve pointer
+	rv := reflect.ValueOf(arg)
+	for rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+
+	switch {
+	case rv.Kind() == reflect.Map && rv.Type().Key().Kind() == reflect.String:
+		return func(name string) (interface{}, bool) {
+			v := rv.MapIndex(reflect.ValueOf(name).Convert(rv.Type().Key()))
+			if !v.IsValid() {
+				return nil, false
+			}
+			return v.Interface(), true
+		}, nil
+	case rv.Kind() == reflect.Struct:
+		fields := cachedFieldIndexes(rv.Type())
+		return func(name string) (interface{}, bool) {
+			index, ok := fields[name]
+			if !ok {
+				return nil, false
+			}
+
+			// If field is in a nil embedded pointer, then returns nil
+			fv, err := rv.FieldByIndexErr(index)
+			if err != nil {
+				return nil, true
+			}
+			return fv.Interface(), true
+		}, nil
+	}
+
+	return nil, NewBuildError(ErrInvalidArgument, "nsql: named variables can only be bound from map or struct, got %T", arg)
+}
+
+// expandValue returns items of slice value. Byte slice and driver.Valuer are not expanded
+func expandValue(v interface{}) ([]interface{}, bool) {
+	if v == nil {
+		return []interface{}{nil}, false
+	}
+
+	if _, ok := v.(driver.Valuer); ok {
+		return []interface{}{v}, false
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array || rv.Type().Elem().Kind() == reflect.Uint8 {
+		return []interface{}{v}, false
+	}
+
+	values := make([]interface{}, rv.Len())
+	for i := range values {
+		values[i] = rv.Index(i).Interface()
+	}
+	return values, true
+}
+
+var fieldIndexesCache sync.Map
+
+// cachedFieldIndexes returns index sequence of struct fields by column name
+func cachedFieldIndexes(t reflect.Type) map[string][]int {
+	if v, ok := fieldIndexesCache.Load(t); ok {
+		return v.(map[string][]int)
+	}
+
+	fields := schema.Fields(t)
+	m := make(map[string][]int, len(fields))
+	for _, f := range fields {
+		m[f.Column] = f.Index
+	}
+
+	v, _ := fieldIndexesCache.LoadOrStore(t, m)
+	return v.(map[string][]int)
+}
+
+// quotedEnd returns position after closing quote of quoted text that is started at i. Doubled quote is treated as an
+// escaped quote
+func quotedEnd(q string, i int) int {
+	c := q[i]
+	if c == '[' {
+		c = ']'
+	}
+	for j := i + 1; j < len(q); j++ {
+		if q[j] != c {
+			continue
+		}
+		if j+1 < len(q) && q[j+1] == c {
+			j++
+			continue
+		}
+		return j + 1
+	}
+	return len(q)
+}
+
+// isQuote returns true if c opens string literal or quoted identifier. Bracket is only a quote for SQL Server, since it
+// is an array subscript in PostgreSQL
+func isQuote(format op.VariableFormat, c byte) bool {
+	return c == '\'' || c == '"' || c == '`' || c == '[' && format == op.AtPVar
+}
+
+func isNameStart(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+func isNameChar(c byte) bool {
+	return isNameStart(c) || c >= '0' && c <= '9' || c == '.'
+}
@@ -0,0 +1,95 @@
+package nsql_test
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/nbs-go/nsql"
+	"github.com/nbs-go/nsql/op"
+	"github.com/nbs-go/nsql/pq/query"
+	"github.com/nbs-go/nsql/schema"
+	"github.com/nbs-go/nsql/test_utils"
+	"testing"
+	"time"
+)
+
+type BaseField struct {
+	CreatedAt time.Time `db:"createdAt"`
+	Id        int64     `db:"id"`
+}
+
+type Customer struct {
+	BaseField
+	FullName string         `db:"fullName"`
+	Email    sql.NullString `db:"email"`
+	Age      int            `db:"-"`
+}
+
+var customer = schema.New(schema.FromModelRef(Customer{}))
+
+func TestBindNamed_Struct(t *testing.T) {
+	createdAt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := Customer{
+		BaseField: BaseField{CreatedAt: createdAt, Id: 1},
+		FullName:  "John",
+	}
+
+	q, args, err := nsql.BindNamed(query.Insert(customer, query.AllColumns).Build(), &c)
+	if err != nil {
+		t.Errorf("Unexpected error. Error=%s", err)
+		return
+	}
+
+	test_utils.CompareString(t, "STRUCT QUERY", q,
+		`INSERT INTO "Customer"("createdAt", "fullName", "email") VALUES (?, ?, ?) RETURNING "id"`)
+	test_utils.CompareInterfaceArray(t, "STRUCT ARGS", args, []interface{}{createdAt, "John", sql.NullString{}})
+}
+
+func TestBindNamed_Map(t *testing.T) {
+	q, args, err := nsql.BindNamedFormat(op.DollarVar,
+		`SELECT * FROM "Customer" WHERE "id" IN (:ids) AND "fullName" = :name AND "createdAt"::date = ':name'`,
+		map[string]interface{}{
+			"ids":  []int64{1, 2, 3},
+			"name": "John",
+		})
+	if err != nil {
+		t.Errorf("Unexpected error. Error=%s", err)
+		return
+	}
+
+	test_utils.CompareString(t, "MAP QUERY", q,
+		`SELECT * FROM "Customer" WHERE "id" IN ($1, $2, $3) AND "fullName" = $4 AND "createdAt"::date = ':name'`)
+	test_utils.CompareInterfaceArray(t, "MAP ARGS", args, []interface{}{int64(1), int64(2), int64(3), "John"})
+}
+
+func TestBindNamed_ByteSlice(t *testing.T) {
+	b := []byte("hello")
+	_, args, err := nsql.BindNamed(`UPDATE "File" SET "content" = :content`, map[string]interface{}{"content": b})
+	if err != nil {
+		t.Errorf("Unexpected error. Error=%s", err)
+		return
+	}
+	test_utils.CompareInt(t, "BYTE SLICE IS NOT EXPANDED", len(args), 1)
+}
+
+func TestBindNamed_Error(t *testing.T) {
+	// Test #1
+	_, _, err := nsql.BindNamed(`SELECT * FROM "Customer" WHERE "id" = :id`, map[string]interface{}{})
+	test_utils.CompareBoolean(t, "MISSING VALUE", errors.Is(err, nsql.ErrNoArguments), true)
+
+	// Test #2
+	_, _, err = nsql.BindNamed(`SELECT * FROM "Customer" WHERE "id" IN (:ids)`, map[string]interface{}{"ids": []int{}})
+	test_utils.CompareBoolean(t, "EMPTY SLICE", errors.Is(err, nsql.ErrNoArguments), true)
+
+	// Test #3
+	_, _, err = nsql.BindNamed(`SELECT * FROM "Customer" WHERE "id" = :id`, 1)
+	test_utils.CompareBoolean(t, "INVALID ARGUMENT", errors.Is(err, nsql.ErrInvalidArgument), true)
+}
+
+func TestRebind(t *testing.T) {
+	test_utils.CompareString(t, "REBIND", nsql.Rebind(op.DollarVar, `SELECT '?' AS "a?" WHERE "id" = ? AND "name" = ?`),
+		`SELECT '?' AS "a?" WHERE "id" = $1 AND "name" = $2`)
+	test_utils.CompareString(t, "REBIND BIND VAR", nsql.Rebind(op.BindVar, `SELECT 1 WHERE "id" = ?`),
+		`SELECT 1 WHERE "id" = ?`)
+	test_utils.CompareString(t, "REBIND AT P VAR", nsql.Rebind(op.AtPVar, `SELECT 1 WHERE [id] = ? AND [name] = ?`),
+		`SELECT 1 WHERE [id] = @p1 AND [name] = @p2`)
+}
@@ -0,0 +1,226 @@
+package builder
+
+import (
+	"github.com/nbs-go/nsql"
+	"github.com/nbs-go/nsql/option"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+type bindVar struct{}
+
+func (b *bindVar) VariableQuery() string {
+	return writeQuery(b.WriteVariableQuery)
+}
+
+func (b *bindVar) WriteVariableQuery(sb *strings.Builder) {
+	sb.WriteByte('?')
+}
+
+func BindVar() option.SetOptionFn {
+	return func(o *option.Options) {
+		o.KV[option.VariableKey] = &bindVar{}
+	}
+}
+
+// Value set value that is bound to variable of condition. Use Values for condition that has more than one bind
+// variables, such as BETWEEN and IN
+func Value(v interface{}) option.SetOptionFn {
+	return func(o *option.Options) {
+		o.KV[option.ValuesKey] = []interface{}{v}
+	}
+}
+
+// Values set values that are bound to variables of condition in order of placeholders
+func Values(v ...interface{}) option.SetOptionFn {
+	return func(o *option.Options) {
+		if v == nil {
+			v = []interface{}{}
+		}
+		o.KV[option.ValuesKey] = v
+	}
+}
+
+// countBindVars returns count of placeholders that is written by variable
+func countBindVars(v nsql.VariableWriter) int {
+	switch bv := v.(type) {
+	case *bindVar:
+		return 1
+	case *betweenBindVar:
+		return 2
+	case *inBindVar:
+		return bv.argCount
+	case *arrayVar:
+		return 1
+	}
+	return 0
+}
+
+// isListVar returns true if variable writes a list or range of values
+func isListVar(v nsql.VariableWriter) bool {
+	switch v.(type) {
+	case *betweenBindVar, *inBindVar, *arrayVar, *emptyListVar:
+		return true
+	}
+	return false
+}
+
+// sliceValues returns items of slice. If v is not a slice or array, then panic
+func sliceValues(v interface{}) []interface{} {
+	if v == nil {
+		return nil
+	}
+
+	// Box items of common slice types without reflection
+	switch sv := v.(type) {
+	case []interface{}:
+		return append([]interface{}(nil), sv...)
+	case []string:
+		return boxValues(sv)
+	case []int:
+		return boxValues(sv)
+	case []int64:
+		return boxValues(sv)
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		panic(nsql.NewBuildError(nsql.ErrInvalidArgument, "nsql: values must be a slice, got %T", v))
+	}
+
+	values := make([]interface{}, rv.Len())
+	for i := range values {
+		values[i] = rv.Index(i).Interface()
+	}
+	return values
+}
+
+// boxValues returns items of slice as interface values
+func boxValues[T any](s []T) []interface{} {
+	values := make([]interface{}, len(s))
+	for i := range s {
+		values[i] = s[i]
+	}
+	return values
+}
+
+// AnyArray set IN condition to be written as "= ANY(?)" with values that are bound as an array by nsql.ArrayBinder, so
+// query has the same placeholder regardless of values count. NOT IN condition will be written as "!= ALL(?)". It will
+// panic if dialect does not implement nsql.ArrayBinder
+func AnyArray() option.SetOptionFn {
+	return func(o *option.Options) {
+		o.KV[anyArrayKey] = true
+	}
+}
+
+// arrayVar write a bind variable of array that is compared with ANY or ALL function
+type arrayVar struct {
+	fn string
+}
+
+func (v *arrayVar) VariableQuery() string {
+	return writeQuery(v.WriteVariableQuery)
+}
+
+func (v *arrayVar) WriteVariableQuery(sb *strings.Builder) {
+	sb.WriteString(v.fn)
+	sb.WriteString("(?)")
+}
+
+// emptyListVar is a variable of IN condition that has no values. Condition that has this variable will be written as a
+// constant predicate
+type emptyListVar struct{}
+
+func (v *emptyListVar) VariableQuery() string {
+	return writeQuery(v.WriteVariableQuery)
+}
+
+func (v *emptyListVar) WriteVariableQuery(sb *strings.Builder) {
+	sb.WriteString("()")
+}
+
+type betweenBindVar struct{}
+
+func (b *betweenBindVar) VariableQuery() string {
+	return writeQuery(b.WriteVariableQuery)
+}
+
+func (b *betweenBindVar) WriteVariableQuery(sb *strings.Builder) {
+	sb.WriteString("? AND ?")
+}
+
+type inBindVar struct {
+	argCount int
+}
+
+func (b *inBindVar) VariableQuery() string {
+	return writeQuery(b.WriteVariableQuery)
+}
+
+func (b *inBindVar) WriteVariableQuery(sb *strings.Builder) {
+	if b.argCount == 0 {
+		panic(nsql.NewBuildError(nsql.ErrNoArguments, "invalid bindVar for IN query, does not have argument"))
+	}
+
+	// Write bind var query
+	sb.Grow(3*b.argCount + 1)
+	sb.WriteString("(?")
+	for i := 1; i < b.argCount; i++ {
+		sb.WriteString(", ?")
+	}
+	sb.WriteByte(')')
+}
+
+type namedVar struct {
+	column string
+}
+
+func (v *namedVar) VariableQuery() string {
+	return writeQuery(v.WriteVariableQuery)
+}
+
+func (v *namedVar) WriteVariableQuery(sb *strings.Builder) {
+	sb.WriteByte(':')
+	sb.WriteString(v.column)
+}
+
+func IntVar(i int) option.SetOptionFn {
+	return func(o *option.Options) {
+		o.KV[option.VariableKey] = &intVar{value: i}
+	}
+}
+
+type intVar struct {
+	value int
+}
+
+func (v *intVar) VariableQuery() string {
+	return writeQuery(v.WriteVariableQuery)
+}
+
+func (v *intVar) WriteVariableQuery(sb *strings.Builder) {
+	sb.WriteString(strconv.Itoa(v.value))
+}
+
+func BoolVar(b bool) option.SetOptionFn {
+	return func(o *option.Options) {
+		o.KV[option.VariableKey] = &boolVar{value: b}
+	}
+}
+
+type boolVar struct {
+	value bool
+}
+
+func (v *boolVar) VariableQuery() string {
+	return writeQuery(v.WriteVariableQuery)
+}
+
+func (v *boolVar) WriteVariableQuery(sb *strings.Builder) {
+	if v.value {
+		sb.WriteString("TRUE")
+		return
+	}
+	sb.WriteString("FALSE")
+}
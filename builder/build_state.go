@@ -0,0 +1,136 @@
+package builder
+
+import (
+	"github.com/nbs-go/nsql"
+	"github.com/nbs-go/nsql/op"
+	"github.com/nbs-go/nsql/schema"
+	"strings"
+)
+
+// buildState holds tables and formats that writers are resolved to on building query. Writers in this package read
+// state on writing query instead of being changed, so a builder can be built concurrently without copying writers
+type buildState struct {
+	// from is schema in FROM clause, it resolves columns that are declared without schema
+	from *schema.Schema
+	// tables is schema that are declared in query by its reference
+	tables map[schema.Reference]*schema.Schema
+	// joined is true if selected columns are written with table prefix in alias
+	joined bool
+	// update is schema of UPDATE or DELETE query, conditions are written as column only with variable format
+	update    *schema.Schema
+	varFormat op.VariableFormat
+}
+
+// selectStateWriter is implemented by select writers that are written with build state
+type selectStateWriter interface {
+	writeSelectState(sb *strings.Builder, st *buildState)
+}
+
+// columnStateWriter is implemented by column writers that are written with build state
+type columnStateWriter interface {
+	writeColumnState(sb *strings.Builder, st *buildState)
+}
+
+// whereStateWriter is implemented by where writers that are written with build state
+type whereStateWriter interface {
+	writeWhereState(sb *strings.Builder, st *buildState)
+}
+
+// table returns schema that writer is resolved to. If writer is not declared in its table or table is not declared
+// in query, then returns nil
+func (st *buildState) table(w nsql.SchemaReference) *schema.Schema {
+	if st.update != nil {
+		return st.update
+	}
+
+	// Resolve writer without schema to FROM table
+	if w.GetTableName() == fromTableFlag {
+		if cg, ok := w.(nsql.ColumnGetter); ok && cg.GetColumn() != AllColumns && !st.from.IsColumnExist(cg.GetColumn()) {
+			return nil
+		}
+		return st.from
+	}
+
+	return st.tables[w.GetSchemaRef()]
+}
+
+// resolve returns table name and alias of writer. If state is not set or writer is not resolved, then declared values
+// are returned
+func (st *buildState) resolve(w nsql.SchemaReference, tableName, tableAs string) (string, string) {
+	if st == nil {
+		return tableName, tableAs
+	}
+	if t := st.table(w); t != nil {
+		tableName = t.TableName()
+		if t.As() != "" {
+			tableAs = t.As()
+		}
+	}
+	return tableName, tableAs
+}
+
+// columnFormat returns format of selected column. If query has joins, then columns are written with table prefix in
+// alias
+func (st *buildState) columnFormat(format op.ColumnFormat) op.ColumnFormat {
+	if st.joined {
+		return op.SelectJoinColumn
+	}
+	return format
+}
+
+// isEmptyWhere returns true if condition writes empty query, so it can be skipped before writing separator
+func (st *buildState) isEmptyWhere(w nsql.WhereWriter) bool {
+	switch cw := w.(type) {
+	case nsql.WhereLogicWriter:
+		for _, c := range cw.GetConditions() {
+			if !st.isEmptyWhere(c) {
+				return false
+			}
+		}
+		return true
+	case nsql.WhereCompareWriter:
+		if st == nil {
+			return cw.GetTableName() == skipTableFlag
+		}
+		return st.table(cw) == nil
+	}
+	return w.WhereQuery() == ""
+}
+
+// whereArgs append values of conditions that are written in query
+func (st *buildState) whereArgs(w nsql.WhereWriter, args []interface{}) []interface{} {
+	switch cw := w.(type) {
+	case nsql.WhereLogicWriter:
+		for _, c := range cw.GetConditions() {
+			args = st.whereArgs(c, args)
+		}
+		return args
+	case nsql.WhereCompareWriter:
+		if st.table(cw) == nil {
+			return args
+		}
+	}
+
+	if ag, ok := w.(nsql.ArgsGetter); ok {
+		args = append(args, ag.GetArgs()...)
+	}
+	return args
+}
+
+// writeColumnState write column with build state if column is implemented by this package
+func writeColumnState(sb *strings.Builder, w nsql.ColumnWriter, st *buildState) {
+	if sw, ok := w.(columnStateWriter); ok {
+		sw.writeColumnState(sb, st)
+		return
+	}
+	w.WriteColumnQuery(sb)
+}
+
+// writeWhereState write condition with build state if condition is implemented by this package
+func writeWhereState(sb *strings.Builder, w nsql.WhereWriter, st *buildState) {
+	if sw, ok := w.(whereStateWriter); ok {
+		sw.writeWhereState(sb, st)
+		return
+	}
+	w.WriteWhereQuery(sb)
+}
@@ -0,0 +1,72 @@
+// Package builder implements query builder that write query in SQL syntax of nsql.Dialect. Database packages, such as
+// pq/query and mysql/query, wrap Builder with their dialect.
+//
+// Building query does not change writers. Writers that are not implemented by this package are written as declared,
+// so they must be resolved to their schema, alias and format by caller and must be immutable while they are built
+package builder
+
+import (
+	"github.com/nbs-go/nsql"
+	"github.com/nbs-go/nsql/option"
+	"strings"
+	"sync"
+)
+
+// Builder create query writers and builders that write query with dialect
+type Builder struct {
+	dialect nsql.Dialect
+	// cache stores queries that are written by Cached
+	cache sync.Map
+}
+
+// New create a Builder for dialect
+func New(d nsql.Dialect) *Builder {
+	return &Builder{dialect: d}
+}
+
+// Dialect returns dialect of builder
+func (q *Builder) Dialect() nsql.Dialect {
+	return q.dialect
+}
+
+// namespaceOption returns namespace that is set by option.Namespace to override namespace of tables. It panics if
+// namespace is not a safe identifier
+func namespaceOption(args []interface{}) string {
+	opts := option.EvaluateOptions(args)
+	ns, ok := opts.GetNamespace()
+	if !ok {
+		return ""
+	}
+	if err := nsql.ValidateIdent(ns); err != nil {
+		panic(err)
+	}
+	return ns
+}
+
+// writeTable write quoted table name that is qualified by namespace if it is set. Override replaces namespace of table
+// if it is set
+func (q *Builder) writeTable(sb *strings.Builder, override string, namespace string, tableName string) {
+	if override != "" {
+		namespace = override
+	}
+
+	if namespace != "" {
+		sb.WriteString(q.dialect.QuoteIdent(namespace))
+		sb.WriteByte('.')
+	}
+	sb.WriteString(q.dialect.QuoteIdent(tableName))
+}
+
+// writeAs write alias of expression that has been written to sb
+func (q *Builder) writeAs(sb *strings.Builder, as string) {
+	sb.WriteString(" AS ")
+	sb.WriteString(q.dialect.QuoteIdent(as))
+}
+
+// writeQuery returns query that is written by fn. String methods of writers wrap their Write method with writeQuery,
+// so each writer has a single implementation of query
+func writeQuery(fn func(sb *strings.Builder)) string {
+	var sb strings.Builder
+	fn(&sb)
+	return sb.String()
+}
@@ -0,0 +1,87 @@
+package builder_test
+
+import (
+	"github.com/nbs-go/nsql"
+	"github.com/nbs-go/nsql/builder"
+	"github.com/nbs-go/nsql/op"
+	"github.com/nbs-go/nsql/option"
+	"github.com/nbs-go/nsql/schema"
+	"github.com/nbs-go/nsql/test_utils"
+	"strings"
+	"testing"
+)
+
+// bracketDialect quote identifiers with brackets and write limit as FETCH FIRST
+type bracketDialect struct {
+	nsql.StandardDialect
+}
+
+func (bracketDialect) Name() string {
+	return "Bracket"
+}
+
+func (bracketDialect) QuoteIdent(name string) string {
+	return nsql.QuoteIdent(name, '[', ']')
+}
+
+func (d bracketDialect) Operator(o op.Operator) string {
+	if o == op.NotEqual {
+		return "<>"
+	}
+	return d.StandardDialect.Operator(o)
+}
+
+func (bracketDialect) WriteLimitQuery(sb *strings.Builder, limit *int64, offset *int64) {
+	if offset != nil {
+		sb.WriteString(" OFFSET ")
+		nsql.WriteInt(sb, *offset)
+		sb.WriteString(" ROWS")
+	}
+	if limit != nil {
+		sb.WriteString(" FETCH FIRST ")
+		nsql.WriteInt(sb, *limit)
+		sb.WriteString(" ROWS ONLY")
+	}
+}
+
+var person = schema.New(schema.TableName("Person"), schema.Columns("id", "name", "status"), schema.AutoIncrement(true))
+
+func TestBuilder_Dialect(t *testing.T) {
+	q := builder.New(bracketDialect{})
+
+	// Test #1
+	actual := q.Select(q.Column("*"), q.Count("id", option.As("total"))).From(person).
+		Where(q.NotEqual(q.Column("status")), q.ILike(q.Column("name"))).
+		Limit(10).Skip(20).
+		Build()
+	test_utils.CompareString(t, "SELECT", actual,
+		"SELECT [Person].[id], [Person].[name], [Person].[status], COUNT([Person].[id]) AS [total] FROM [Person] "+
+			"WHERE [Person].[status] <> ? AND [Person].[name] ILIKE ? OFFSET 20 ROWS FETCH FIRST 10 ROWS ONLY")
+
+	// Test #2
+	actual = q.Insert(person, builder.AllColumns).Build(option.VariableFormat(op.BindVar))
+	test_utils.CompareString(t, "INSERT WITHOUT RETURNING", actual, "INSERT INTO [Person]([name], [status]) VALUES (?, ?)")
+
+	// Test #3
+	actual = q.Schema(person).Update()
+	test_utils.CompareString(t, "UPDATE", actual, "UPDATE [Person] SET [name] = :name, [status] = :status WHERE [id] = :id")
+}
+
+func TestBuilder_UnsupportedFeatures(t *testing.T) {
+	q := builder.New(bracketDialect{})
+
+	t.Run("DollarVar", func(t *testing.T) {
+		defer test_utils.RecoverPanic(t, "DOLLAR VAR", "op.DollarVar variable format is not supported by Bracket")()
+		q.Select(q.Column("*")).From(person).Build(option.VariableFormat(op.DollarVar))
+	})
+
+	t.Run("AnyArray", func(t *testing.T) {
+		defer test_utils.RecoverPanic(t, "ANY ARRAY", "AnyArray option is not supported by Bracket")()
+		q.InValues(q.Column("id"), []int{1, 2}, builder.AnyArray())
+	})
+
+	t.Run("JsonColumn", func(t *testing.T) {
+		defer test_utils.RecoverPanic(t, "JSON COLUMN", "nsql: JsonColumn is not supported by Bracket")()
+		q.JsonColumn("data.name")
+	})
+}
@@ -0,0 +1,17 @@
+package builder
+
+// Cached returns query that is cached by key. If key is not cached, then fn is called to write query and the result is
+// cached for next calls. Cache is never evicted, so key must identify a query shape that is declared statically, such
+// as a query in repository method, and must not be derived from request values. Values must be passed as arguments
+// to bind variables.
+//
+// fn may be called more than once if Cached is called concurrently with the same key before it is cached, but only
+// the first result is stored
+func (q *Builder) Cached(key string, fn func() string) string {
+	if v, ok := q.cache.Load(key); ok {
+		return v.(string)
+	}
+
+	v, _ := q.cache.LoadOrStore(key, fn())
+	return v.(string)
+}
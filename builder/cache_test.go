@@ -0,0 +1,59 @@
+package builder_test
+
+import (
+	"github.com/nbs-go/nsql/builder"
+	"github.com/nbs-go/nsql/option"
+	"github.com/nbs-go/nsql/test_utils"
+	"testing"
+)
+
+func TestBuilder_Cached(t *testing.T) {
+	q := builder.New(bracketDialect{})
+
+	calls := 0
+	build := func() string {
+		calls++
+		return q.Select(q.Column("*")).From(person).Where(q.Equal(q.Column("status"))).Build()
+	}
+
+	expected := "SELECT [Person].[id], [Person].[name], [Person].[status] FROM [Person] WHERE [Person].[status] = ?"
+	test_utils.CompareString(t, "FIRST CALL", q.Cached("person.byStatus", build), expected)
+	test_utils.CompareString(t, "SECOND CALL", q.Cached("person.byStatus", build), expected)
+	test_utils.CompareInt(t, "BUILD CALLS", calls, 1)
+
+	// Cache is not shared between builders
+	other := builder.New(bracketDialect{})
+	other.Cached("person.byStatus", func() string { return "" })
+	test_utils.CompareString(t, "OTHER BUILDER", q.Cached("person.byStatus", build), expected)
+}
+
+func buildJoinSelect(q *builder.Builder) string {
+	return q.Select(q.Column("id"), q.Column("plate", option.Schema(vehicle))).
+		From(person).
+		Join(vehicle, q.Equal(q.Column("id"), q.On("personId"))).
+		Where(q.Equal(q.Column("status")), q.IsNotNull(q.Column("name"))).
+		OrderBy("name").
+		Limit(10).
+		Build()
+}
+
+func BenchmarkSelect_Build(b *testing.B) {
+	q := builder.New(bracketDialect{})
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = buildJoinSelect(q)
+	}
+}
+
+func BenchmarkSelect_Cached(b *testing.B) {
+	q := builder.New(bracketDialect{})
+	build := func() string {
+		return buildJoinSelect(q)
+	}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = q.Cached("person.listWithVehicle", build)
+	}
+}
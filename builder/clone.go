@@ -0,0 +1,155 @@
+package builder
+
+import (
+	"github.com/nbs-go/nsql"
+	"github.com/nbs-go/nsql/schema"
+)
+
+// Clone returns a deep copy of SelectBuilder. Declaring query on clone will not change the original builder, so a base
+// query can be shared and extended per request. Writers that are not implemented by this package are shared
+func (b *SelectBuilder) Clone() *SelectBuilder {
+	c := *b
+
+	// Copy writers
+	c.fields = make([]nsql.SelectWriter, len(b.fields))
+	for i, f := range b.fields {
+		c.fields[i] = cloneWriter(f).(nsql.SelectWriter)
+	}
+	c.orderBys = make([]nsql.OrderByWriter, len(b.orderBys))
+	for i, o := range b.orderBys {
+		c.orderBys[i] = cloneWriter(o).(nsql.OrderByWriter)
+	}
+	if b.from != nil {
+		c.from = cloneWriter(b.from).(nsql.FromWriter)
+	}
+	if b.where != nil {
+		c.where = cloneWriter(b.where).(nsql.WhereWriter)
+	}
+
+	// Copy tables and options
+	c.schemaRef = make(map[schema.Reference]*schema.Schema, len(b.schemaRef))
+	for k, v := range b.schemaRef {
+		c.schemaRef[k] = v
+	}
+	c.limit = cloneInt64(b.limit)
+	c.skip = cloneInt64(b.skip)
+	c.dropped = b.dropped.clone()
+
+	return &c
+}
+
+// Clone returns a deep copy of InsertBuilder
+func (b *InsertBuilder) Clone() *InsertBuilder {
+	c := *b
+	c.columns = append([]string(nil), b.columns...)
+	c.dropped = b.dropped.clone()
+	c.values = b.values.clone()
+	if b.conflict != nil {
+		conflict := *b.conflict
+		conflict.columns = append([]string(nil), b.conflict.columns...)
+		conflict.update = append([]string(nil), b.conflict.update...)
+		c.conflict = &conflict
+	}
+	return &c
+}
+
+// Clone returns a deep copy of UpdateBuilder
+func (b *UpdateBuilder) Clone() *UpdateBuilder {
+	c := *b
+	c.columns = append([]string(nil), b.columns...)
+	if b.where != nil {
+		c.where = cloneWriter(b.where).(nsql.WhereWriter)
+	}
+	c.dropped = b.dropped.clone()
+	c.values = b.values.clone()
+	return &c
+}
+
+// Clone returns a deep copy of DeleteBuilder
+func (b *DeleteBuilder) Clone() *DeleteBuilder {
+	c := *b
+	if b.where != nil {
+		c.where = cloneWriter(b.where).(nsql.WhereWriter)
+	}
+	return &c
+}
+
+// Clone returns a copy of SchemaBuilder
+func (s *SchemaBuilder) Clone() *SchemaBuilder {
+	c := *s
+	return &c
+}
+
+// Clone returns a deep copy of FilterBuilder
+func (b *FilterBuilder) Clone() *FilterBuilder {
+	c := FilterBuilder{
+		conditions: make([]nsql.WhereWriter, len(b.conditions)),
+		args:       append([]interface{}(nil), b.args...),
+	}
+	for i, w := range b.conditions {
+		c.conditions[i] = cloneWriter(w).(nsql.WhereWriter)
+	}
+	return &c
+}
+
+// cloneWriter returns a copy of writer that is implemented by this package, including writers that it wraps. Other
+// writers are returned as is
+func cloneWriter(w interface{}) interface{} {
+	switch v := w.(type) {
+	case *ColumnWriter:
+		c := *v
+		return &c
+	case *ColumnSchemaWriter:
+		c := *v
+		return &c
+	case *JsonColumnWriter:
+		c := *v
+		return &c
+	case *LowerColumnWriter:
+		c := *v
+		c.ColumnWriter = cloneWriter(v.ColumnWriter).(nsql.ColumnWriter)
+		return &c
+	case *SelectCountWriter:
+		c := *v
+		c.ColumnWriter = cloneWriter(v.ColumnWriter).(nsql.ColumnWriter)
+		return &c
+	case *orderByWriter:
+		c := *v
+		c.ColumnWriter = cloneWriter(v.ColumnWriter).(nsql.ColumnWriter)
+		return &c
+	case *WhereCompareWriter:
+		c := *v
+		c.ColumnWriter = cloneWriter(v.ColumnWriter).(nsql.ColumnWriter)
+		if v.variable != nil {
+			c.variable = cloneWriter(v.variable).(nsql.VariableWriter)
+		}
+		return &c
+	case *WhereLogicWriter:
+		c := *v
+		c.conditions = make([]nsql.WhereWriter, len(v.conditions))
+		for i, cw := range v.conditions {
+			c.conditions[i] = cloneWriter(cw).(nsql.WhereWriter)
+		}
+		return &c
+	case *tableWriter:
+		c := *v
+		c.joints = make(map[schema.Reference]nsql.JoinWriter, len(v.joints))
+		for k, j := range v.joints {
+			c.joints[k] = cloneWriter(j).(nsql.JoinWriter)
+		}
+		return &c
+	case *joinWriter:
+		c := *v
+		c.onCondition = cloneWriter(v.onCondition).(nsql.WhereWriter)
+		return &c
+	}
+	return w
+}
+
+func cloneInt64(n *int64) *int64 {
+	if n == nil {
+		return nil
+	}
+	v := *n
+	return &v
+}
@@ -0,0 +1,263 @@
+package builder_test
+
+import (
+	"github.com/nbs-go/nsql"
+	"github.com/nbs-go/nsql/builder"
+	"github.com/nbs-go/nsql/op"
+	"github.com/nbs-go/nsql/option"
+	"github.com/nbs-go/nsql/schema"
+	"github.com/nbs-go/nsql/test_utils"
+	"strings"
+	"sync"
+	"testing"
+)
+
+var vehicle = schema.New(schema.TableName("Vehicle"), schema.Columns("id", "personId", "plate"), schema.As("v"))
+
+func TestSelectBuilder_ConcurrentBuild(t *testing.T) {
+	q := builder.New(bracketDialect{})
+
+	b := q.Select(q.Column("id"), q.Column("plate", option.Schema(vehicle))).
+		From(person).
+		Join(vehicle, q.Equal(q.Column("id"), q.On("personId"))).
+		Where(q.Equal(q.Column("status")), builder.Or(q.Like(q.Column("name")), q.IsNotNull(q.Column("name")))).
+		OrderBy("name").
+		Limit(10)
+
+	expected := b.Build()
+
+	// Build concurrently
+	var wg sync.WaitGroup
+	results := make([]string, 100)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = b.Build()
+		}(i)
+	}
+	wg.Wait()
+
+	for _, actual := range results {
+		test_utils.CompareString(t, "CONCURRENT SELECT", actual, expected)
+	}
+}
+
+func TestUpdateDeleteBuilder_ConcurrentBuild(t *testing.T) {
+	q := builder.New(bracketDialect{})
+
+	u := q.Update(person, "name", "status")
+	d := q.Delete(person).Where(q.Equal(q.Column("status")))
+
+	expectedUpdate := map[op.VariableFormat]string{
+		op.BindVar:  "UPDATE [Person] SET [name] = ?, [status] = ? WHERE [id] = ?",
+		op.NamedVar: "UPDATE [Person] SET [name] = :name, [status] = :status WHERE [id] = :id",
+	}
+	expectedDelete := map[op.VariableFormat]string{
+		op.BindVar:  "DELETE FROM [Person] WHERE [status] = ?",
+		op.NamedVar: "DELETE FROM [Person] WHERE [status] = :status",
+	}
+
+	var wg sync.WaitGroup
+	updates := make([]string, 100)
+	deletes := make([]string, 100)
+	for i := range updates {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			updates[i] = u.Build(option.VariableFormat(formatAt(i)))
+			deletes[i] = d.Build(option.VariableFormat(formatAt(i)))
+		}(i)
+	}
+	wg.Wait()
+
+	for i := range updates {
+		test_utils.CompareString(t, "UPDATE", updates[i], expectedUpdate[formatAt(i)])
+		test_utils.CompareString(t, "DELETE", deletes[i], expectedDelete[formatAt(i)])
+	}
+}
+
+func TestSelectBuilder_BuildIsIdempotent(t *testing.T) {
+	q := builder.New(bracketDialect{})
+
+	b := q.Select(q.Column("*")).From(person).Where(q.Equal(q.Column("status"), builder.Value("active")))
+
+	// Build must not change builder, so the next build writes the same query and args
+	expected := "SELECT [Person].[id], [Person].[name], [Person].[status] FROM [Person] WHERE [Person].[status] = ?"
+	test_utils.CompareString(t, "FIRST BUILD", b.Build(), expected)
+	test_utils.CompareString(t, "SECOND BUILD", b.Build(), expected)
+
+	actual, args := b.BuildWithArgs()
+	test_utils.CompareString(t, "BUILD WITH ARGS", actual, expected)
+	test_utils.CompareInterfaceArray(t, "ARGS", args, []interface{}{"active"})
+}
+
+func TestBuild_SharedWriters(t *testing.T) {
+	q := builder.New(bracketDialect{})
+
+	// Writers are shared by builders that resolve them to different tables and formats
+	col := q.Column("id")
+	cond := q.Equal(q.Column("status"))
+	joined := q.Select(col).From(person).Join(vehicle, q.Equal(q.Column("id"), q.On("personId"))).Where(cond)
+	single := q.Select(col).From(person).Where(cond)
+	d := q.Delete(person).Where(cond)
+
+	test_utils.CompareString(t, "DELETE", d.Build(option.VariableFormat(op.NamedVar)),
+		"DELETE FROM [Person] WHERE [status] = :status")
+	test_utils.CompareString(t, "JOINED SELECT", joined.Build(),
+		"SELECT [Person].[id] AS [Person.id] FROM [Person] INNER JOIN [Vehicle] AS [v] ON [Person].[id] = [v].[personId] "+
+			"WHERE [Person].[status] = ?")
+	test_utils.CompareString(t, "SELECT", single.Build(), "SELECT [Person].[id] FROM [Person] WHERE [Person].[status] = ?")
+}
+
+func TestSelectBuilder_Clone(t *testing.T) {
+	q := builder.New(bracketDialect{})
+
+	b := q.Select(q.Column("id")).From(person).Where(q.Equal(q.Column("status"))).Limit(10)
+	expected := b.Build()
+
+	// Modify clone
+	c := b.Clone().Where(q.Equal(q.Column("name"))).Limit(5).OrderBy("id")
+
+	test_utils.CompareString(t, "ORIGINAL", b.Build(), expected)
+	test_utils.CompareString(t, "CLONE", c.Build(),
+		"SELECT [Person].[id] FROM [Person] WHERE [Person].[name] = ? ORDER BY [Person].[id] ASC FETCH FIRST 5 ROWS ONLY")
+}
+
+func TestUpdateBuilder_Clone(t *testing.T) {
+	q := builder.New(bracketDialect{})
+
+	b := q.Update(person, "name").Where(q.Equal(q.Column("id"), builder.Value(1))).Values("John")
+	c := b.Clone().Where(q.Equal(q.Column("status"), builder.Value("active"))).Values("Jane")
+
+	actual, args := b.BuildWithArgs()
+	test_utils.CompareString(t, "ORIGINAL", actual, "UPDATE [Person] SET [name] = ? WHERE [id] = ?")
+	test_utils.CompareInterfaceArray(t, "ORIGINAL ARGS", args, []interface{}{"John", 1})
+
+	actual, args = c.BuildWithArgs()
+	test_utils.CompareString(t, "CLONE", actual, "UPDATE [Person] SET [name] = ? WHERE [status] = ?")
+	test_utils.CompareInterfaceArray(t, "CLONE ARGS", args, []interface{}{"Jane", "active"})
+}
+
+func TestBuild_ConcurrentExternalWriters(t *testing.T) {
+	q := builder.New(bracketDialect{})
+
+	// Writers that are not implemented by builder are written as declared, so they can be shared by concurrent builds
+	col := &rawWriter{table: person, column: "name"}
+	cond := &rawWriter{table: person, column: "status"}
+	s := q.Select(col).From(person).Join(vehicle, q.Equal(q.Column("id"), q.On("personId"))).Where(cond)
+	u := q.Update(person, "name").Where(cond)
+
+	var wg sync.WaitGroup
+	selects := make([]string, 100)
+	updates := make([]string, 100)
+	for i := range selects {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			selects[i] = s.Build()
+			updates[i] = u.Build(option.VariableFormat(formatAt(i)))
+		}(i)
+	}
+	wg.Wait()
+
+	expectedUpdate := map[op.VariableFormat]string{
+		op.BindVar:  "UPDATE [Person] SET [name] = ? WHERE [Person].[status] IS NOT NULL",
+		op.NamedVar: "UPDATE [Person] SET [name] = :name WHERE [Person].[status] IS NOT NULL",
+	}
+	for i := range selects {
+		test_utils.CompareString(t, "SELECT", selects[i],
+			"SELECT [Person].[name] FROM [Person] INNER JOIN [Vehicle] AS [v] ON [Person].[id] = [v].[personId] "+
+				"WHERE [Person].[status] IS NOT NULL")
+		test_utils.CompareString(t, "UPDATE", updates[i], expectedUpdate[formatAt(i)])
+	}
+}
+
+// rawWriter write column and condition as declared. Setters change writer without lock, so calling them on build is
+// reported by race detector
+type rawWriter struct {
+	table    *schema.Schema
+	column   string
+	as       string
+	format   op.ColumnFormat
+	variable nsql.VariableWriter
+}
+
+func (w *rawWriter) writeColumn(sb *strings.Builder) {
+	sb.WriteString("[" + w.table.TableName() + "].[" + w.column + "]")
+}
+
+func (w *rawWriter) SelectQuery() string {
+	var sb strings.Builder
+	w.WriteSelectQuery(&sb)
+	return sb.String()
+}
+
+func (w *rawWriter) WriteSelectQuery(sb *strings.Builder) {
+	w.writeColumn(sb)
+}
+
+func (w *rawWriter) ColumnQuery() string {
+	var sb strings.Builder
+	w.WriteColumnQuery(&sb)
+	return sb.String()
+}
+
+func (w *rawWriter) WriteColumnQuery(sb *strings.Builder) {
+	w.writeColumn(sb)
+}
+
+func (w *rawWriter) WhereQuery() string {
+	var sb strings.Builder
+	w.WriteWhereQuery(&sb)
+	return sb.String()
+}
+
+func (w *rawWriter) WriteWhereQuery(sb *strings.Builder) {
+	w.writeColumn(sb)
+	sb.WriteString(" IS NOT NULL")
+}
+
+func (w *rawWriter) SetFormat(format op.ColumnFormat) {
+	w.format = format
+}
+
+func (w *rawWriter) IsAllColumns() bool {
+	return false
+}
+
+func (w *rawWriter) SetTableAs(as string) {
+	w.as = as
+}
+
+func (w *rawWriter) GetTableName() string {
+	return w.table.TableName()
+}
+
+func (w *rawWriter) GetSchemaRef() schema.Reference {
+	return w.table.Ref()
+}
+
+func (w *rawWriter) SetSchema(s *schema.Schema) {
+	w.table = s
+}
+
+func (w *rawWriter) GetColumn() string {
+	return w.column
+}
+
+func (w *rawWriter) GetVariable() nsql.VariableWriter {
+	return w.variable
+}
+
+func (w *rawWriter) SetVariable(v nsql.VariableWriter) {
+	w.variable = v
+}
+
+// formatAt returns alternating variable format by index
+func formatAt(i int) op.VariableFormat {
+	if i%2 == 0 {
+		return op.BindVar
+	}
+	return op.NamedVar
+}
@@ -0,0 +1,245 @@
+package builder
+
+import (
+	"github.com/nbs-go/nsql"
+	"github.com/nbs-go/nsql/op"
+	"github.com/nbs-go/nsql/option"
+	"github.com/nbs-go/nsql/schema"
+	"strings"
+)
+
+func (q *Builder) Column(col string, args ...interface{}) *ColumnWriter {
+	// Evaluate options
+	opts := option.EvaluateOptions(args)
+
+	// Get tableName
+	s := opts.GetSchema()
+	var tableName, tableAs string
+	if s == nil {
+		tableName = fromTableFlag
+	} else {
+		tableName = s.TableName()
+		tableAs = s.As()
+	}
+
+	// Get format
+	format, ok := opts.GetColumnFormat()
+	if !ok {
+		format = op.NonAmbiguousColumn
+	}
+
+	// Get alias
+	as, _ := opts.GetString(option.AsKey)
+
+	return &ColumnWriter{
+		qb:        q,
+		name:      col,
+		tableName: tableName,
+		tableAs:   tableAs,
+		format:    format,
+		as:        as,
+	}
+}
+
+// Col create a column writer from a typed column reference, column will always be resolved to the referenced schema
+func (q *Builder) Col(ref schema.ColumnRef, args ...interface{}) *ColumnWriter {
+	return q.Column(ref.Name(), append([]interface{}{option.Schema(ref.Schema())}, args...)...)
+}
+
+func (q *Builder) Columns(column1, column2 string, args ...interface{}) *ColumnSchemaWriter {
+	// Init columns containers
+	var inColumns []string
+
+	// Evaluate arguments
+	optCopy := option.NewOptions()
+	for _, v := range args {
+		switch cv := v.(type) {
+		case option.SetOptionFn:
+			cv(optCopy)
+		case string:
+			inColumns = append(inColumns, cv)
+		}
+	}
+
+	// Get schema
+	s := optCopy.GetSchema()
+	// Undeclared columns will be filtered out on writing query
+	cols := append([]string{column1, column2}, inColumns...)
+	var tableName string
+	if s == nil {
+		tableName = fromTableFlag
+	} else {
+		tableName = s.TableName()
+	}
+
+	// Get format
+	format, ok := optCopy.GetColumnFormat()
+	if !ok {
+		format = op.NonAmbiguousColumn
+	}
+
+	// Create schema writer
+	return &ColumnSchemaWriter{
+		qb:        q,
+		schema:    s,
+		columns:   cols,
+		tableName: tableName,
+		format:    format,
+	}
+}
+
+// ColumnWriter implements query.SelectWriter for a single column
+type ColumnWriter struct {
+	qb        *Builder
+	name      string
+	tableName string
+	tableAs   string
+	format    op.ColumnFormat
+	as        string
+}
+
+func (w *ColumnWriter) GetSchemaRef() schema.Reference {
+	if w.tableAs != "" {
+		return schema.Reference(w.tableAs)
+	}
+	return schema.Reference(w.tableName)
+}
+
+func (w *ColumnWriter) VariableQuery() string {
+	return writeQuery(w.WriteVariableQuery)
+}
+
+func (w *ColumnWriter) WriteVariableQuery(sb *strings.Builder) {
+	w.WriteColumnQuery(sb)
+}
+
+func (w *ColumnWriter) GetColumn() string {
+	return w.name
+}
+
+func (w *ColumnWriter) SetSchema(s *schema.Schema) {
+	// Check if column is part of schema
+	if w.name != AllColumns && !s.IsColumnExist(w.name) {
+		// Mark column to be skipped from writer
+		w.tableName = skipTableFlag
+		return
+	}
+	w.tableName = s.TableName()
+	w.tableAs = s.As()
+}
+
+func (w *ColumnWriter) Expand(args ...interface{}) nsql.SelectWriter {
+	// Get schema
+	opts := option.EvaluateOptions(args)
+	s := opts.GetSchema()
+
+	// Expand to ColumnWriter schema
+	return &ColumnSchemaWriter{
+		qb:        w.qb,
+		schema:    s,
+		columns:   s.Columns(),
+		tableName: s.TableName(),
+	}
+}
+
+func (w *ColumnWriter) ColumnQuery() string {
+	return writeQuery(w.WriteColumnQuery)
+}
+
+func (w *ColumnWriter) WriteColumnQuery(sb *strings.Builder) {
+	w.writeColumnState(sb, nil)
+}
+
+func (w *ColumnWriter) writeColumnState(sb *strings.Builder, st *buildState) {
+	// Write column only in UPDATE or DELETE conditions
+	if st != nil && st.update != nil {
+		w.qb.writeColumn(sb, w.tableName, w.tableAs, w.name, op.ColumnOnly)
+		return
+	}
+
+	tableName, tableAs := st.resolve(w, w.tableName, w.tableAs)
+	w.qb.writeColumn(sb, tableName, tableAs, w.name, w.format)
+}
+
+func (w *ColumnWriter) IsAllColumns() bool {
+	return w.name == AllColumns
+}
+
+func (w *ColumnWriter) SelectQuery() string {
+	return writeQuery(w.WriteSelectQuery)
+}
+
+func (w *ColumnWriter) WriteSelectQuery(sb *strings.Builder) {
+	w.WriteColumnQuery(sb)
+	if w.as != "" {
+		w.qb.writeAs(sb, w.as)
+	}
+}
+
+func (w *ColumnWriter) writeSelectState(sb *strings.Builder, st *buildState) {
+	// If all columns are selected, then expand to columns of table
+	if w.IsAllColumns() {
+		t := st.table(w)
+		w.qb.writeSchemaColumns(sb, t, t.Columns(), t.TableName(), t.As(), st.columnFormat(op.NonAmbiguousColumn))
+		return
+	}
+
+	tableName, tableAs := st.resolve(w, w.tableName, w.tableAs)
+	w.qb.writeColumn(sb, tableName, tableAs, w.name, st.columnFormat(w.format))
+	if w.as != "" {
+		w.qb.writeAs(sb, w.as)
+	}
+}
+
+func (w *ColumnWriter) GetTableName() string {
+	return w.tableName
+}
+
+func (w *ColumnWriter) SetTableAs(as string) {
+	// Ignore if alias is empty
+	if as == "" {
+		return
+	}
+	w.tableAs = as
+}
+
+func (w *ColumnWriter) SetFormat(format op.ColumnFormat) {
+	w.format = format
+}
+
+func (q *Builder) writeColumn(sb *strings.Builder, tableName string, tableAs string, name string, format op.ColumnFormat) {
+	// Set table alias
+	if tableAs != "" {
+		tableName = tableAs
+	}
+
+	quote := q.dialect.QuoteIdent
+	switch format {
+	case op.SelectJoinColumn:
+		sb.WriteString(quote(tableName))
+		sb.WriteByte('.')
+		sb.WriteString(quote(name))
+		q.writeAs(sb, tableName+"."+name)
+	case op.ColumnOnly:
+		sb.WriteString(quote(name))
+	default:
+		// If not set, treat as NonAmbiguous column
+		sb.WriteString(quote(tableName))
+		sb.WriteByte('.')
+		sb.WriteString(quote(name))
+	}
+}
+
+// resolveColumnRefs returns schema and column names of column references. It will panic if columns are referred to
+// different schema
+func resolveColumnRefs(ref1 schema.ColumnRef, refN []schema.ColumnRef) (*schema.Schema, []string) {
+	s := ref1.Schema()
+	columns := []string{ref1.Name()}
+	for _, ref := range refN {
+		if ref.Schema() != s {
+			panic(nsql.NewBuildError(nsql.ErrInvalidArgument, `column "%s" is not referred to schema "%s"`, ref.Name(), s.TableName()))
+		}
+		columns = append(columns, ref.Name())
+	}
+	return s, columns
+}
@@ -0,0 +1,106 @@
+package builder
+
+import (
+	"github.com/nbs-go/nsql"
+	"github.com/nbs-go/nsql/op"
+	"github.com/nbs-go/nsql/schema"
+	"strings"
+)
+
+// ColumnSchemaWriter implements query.SelectWriter for columns in a Schema
+type ColumnSchemaWriter struct {
+	qb        *Builder
+	schema    *schema.Schema
+	columns   []string
+	tableName string
+	tableAs   string
+	format    op.ColumnFormat
+}
+
+func (w *ColumnSchemaWriter) GetSchemaRef() schema.Reference {
+	if w.tableAs != "" {
+		return schema.Reference(w.tableAs)
+	}
+	return schema.Reference(w.tableName)
+}
+
+func (w *ColumnSchemaWriter) SetSchema(s *schema.Schema) {
+	w.schema = s
+	w.tableName = s.TableName()
+	w.tableAs = s.As()
+}
+
+func (w *ColumnSchemaWriter) GetTableName() string {
+	if w.schema != nil {
+		return w.schema.TableName()
+	}
+	return w.tableName
+}
+
+func (w *ColumnSchemaWriter) SelectQuery() string {
+	return writeQuery(w.WriteSelectQuery)
+}
+
+func (w *ColumnSchemaWriter) WriteSelectQuery(sb *strings.Builder) {
+	w.qb.writeSchemaColumns(sb, w.schema, w.columns, w.tableName, w.tableAs, w.format)
+}
+
+func (w *ColumnSchemaWriter) writeSelectState(sb *strings.Builder, st *buildState) {
+	tableName, tableAs := st.resolve(w, w.tableName, w.tableAs)
+	w.qb.writeSchemaColumns(sb, w.resolveSchema(st), w.columns, tableName, tableAs, st.columnFormat(w.format))
+}
+
+func (w *ColumnSchemaWriter) SetFormat(format op.ColumnFormat) {
+	w.format = format
+}
+
+func (w *ColumnSchemaWriter) SetTableAs(as string) {
+	if as == "" {
+		return
+	}
+	w.tableName = as
+}
+
+func (w *ColumnSchemaWriter) IsAllColumns() bool {
+	return false
+}
+
+// resolveSchema returns schema of columns. If schema is not set, then columns are resolved to FROM table
+func (w *ColumnSchemaWriter) resolveSchema(st *buildState) *schema.Schema {
+	if w.schema == nil {
+		return st.from
+	}
+	return w.schema
+}
+
+// undeclaredColumns returns columns that are not declared in schema
+func (w *ColumnSchemaWriter) undeclaredColumns(s *schema.Schema) []string {
+	var cols []string
+	for _, col := range w.columns {
+		if !s.IsColumnExist(col) {
+			cols = append(cols, col)
+		}
+	}
+	return cols
+}
+
+// writeSchemaColumns write columns that are declared in schema
+func (q *Builder) writeSchemaColumns(sb *strings.Builder, s *schema.Schema, columns []string, tableName, tableAs string,
+	format op.ColumnFormat) {
+	first := true
+	for _, col := range columns {
+		// Skip if column is not declared
+		if !s.IsColumnExist(col) {
+			continue
+		}
+
+		// Write separator
+		if !first {
+			sb.WriteString(nsql.Separator)
+		}
+		first = false
+
+		// Write column
+		q.writeColumn(sb, tableName, tableAs, col, format)
+	}
+}
@@ -1,4 +1,4 @@
-package query
+package builder
 
 // Flags, start with underscore to prevent table naming collision
 
@@ -10,3 +10,9 @@ const (
 
 	AllColumns = "*"
 )
+
+// Option keys
+
+const (
+	anyArrayKey = "anyArray"
+)
@@ -0,0 +1,91 @@
+package builder
+
+import (
+	"github.com/nbs-go/nsql"
+	"github.com/nbs-go/nsql/op"
+	"github.com/nbs-go/nsql/option"
+	"github.com/nbs-go/nsql/schema"
+	"strings"
+)
+
+type DeleteBuilder struct {
+	qb     *Builder
+	schema *schema.Schema
+	where  nsql.WhereWriter
+}
+
+func (b *DeleteBuilder) Build(args ...interface{}) string {
+	// Get variable format option
+	opts := option.EvaluateOptions(args)
+	format, ok := opts.GetVariableFormat()
+	if !ok {
+		// If var format is not defined, then set default to query.BindVar
+		format = b.qb.defaultFormat(op.BindVar)
+	}
+
+	return b.build(format, namespaceOption(args))
+}
+
+// BuildWithArgs build query with bind variables and returns values that are bound to WHERE conditions in order of
+// placeholders. If Where is not set, then value of primary key condition must be set by Value option
+func (b *DeleteBuilder) BuildWithArgs(args ...interface{}) (string, []interface{}) {
+	q := b.build(b.qb.bindVarFormat(args), namespaceOption(args))
+
+	var values []interface{}
+	if b.where == nil {
+		values = primaryKeyArgs("DELETE", b.schema, args)
+	} else if ag, ok := b.where.(nsql.ArgsGetter); ok {
+		values = ag.GetArgs()
+	}
+
+	return q, values
+}
+
+func (b *DeleteBuilder) build(format op.VariableFormat, namespace string) string {
+	// Write positional placeholders by rebinding query that is written with bind variables
+	if isPositional(format) {
+		b.qb.checkPositional(format)
+		return nsql.Rebind(format, b.build(op.BindVar, namespace))
+	}
+
+	// Check conditions are declared in deleted table, variable format is written with build state
+	where := b.whereWriter()
+	checkUpdateConditions(where, b.schema)
+	st := &buildState{update: b.schema, varFormat: format}
+
+	var sb strings.Builder
+	sb.WriteString("DELETE FROM ")
+	b.qb.writeTable(&sb, namespace, b.schema.Namespace(), b.schema.TableName())
+
+	// Write where
+	sb.WriteString(" WHERE ")
+	writeWhereState(&sb, where, st)
+
+	return sb.String()
+}
+
+// BuildE build query and returns nsql.BuildError instead of panic on invalid query declaration
+func (b *DeleteBuilder) BuildE(args ...interface{}) (q string, err error) {
+	defer nsql.RecoverBuildError(&err)
+	return b.Build(args...), nil
+}
+
+func (b *DeleteBuilder) Where(w nsql.WhereWriter) *DeleteBuilder {
+	b.where = w
+	return b
+}
+
+func (q *Builder) Delete(s *schema.Schema) *DeleteBuilder {
+	return &DeleteBuilder{
+		qb:     q,
+		schema: s,
+	}
+}
+
+// whereWriter returns where conditions, or condition on primary key if where is not set
+func (b *DeleteBuilder) whereWriter() nsql.WhereWriter {
+	if b.where == nil {
+		return b.qb.Equal(b.qb.Column(b.schema.PrimaryKey(), option.Schema(b.schema)))
+	}
+	return b.where
+}
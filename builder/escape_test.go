@@ -0,0 +1,160 @@
+package builder_test
+
+import (
+	"errors"
+	"github.com/nbs-go/nsql"
+	"github.com/nbs-go/nsql/builder"
+	mssqlQuery "github.com/nbs-go/nsql/mssql/query"
+	mysqlQuery "github.com/nbs-go/nsql/mysql/query"
+	"github.com/nbs-go/nsql/option"
+	pqQuery "github.com/nbs-go/nsql/pq/query"
+	"github.com/nbs-go/nsql/schema"
+	sqliteQuery "github.com/nbs-go/nsql/sqlite/query"
+	"strings"
+	"testing"
+)
+
+var escapeDialects = []nsql.Dialect{pqQuery.Dialect{}, mysqlQuery.Dialect{}, sqliteQuery.Dialect{}, mssqlQuery.Dialect{}}
+
+var content = schema.New(schema.TableName("Content"), schema.Columns("id", "content"))
+
+var escapeSeeds = []string{
+	"name",
+	`x" FROM "Secret" --`,
+	"x` FROM `Secret` --",
+	"x] FROM [Secret] --",
+	"x' OR '1'='1",
+	`x\' OR 1=1 --`,
+	"x?y",
+	"a\x00b",
+}
+
+// FuzzAlias asserts that alias is always written inside a single quoted identifier, so the query has the same structure
+// as query with a plain alias
+func FuzzAlias(f *testing.F) {
+	for _, s := range escapeSeeds {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, alias string) {
+		if alias == "" {
+			return
+		}
+
+		for _, d := range escapeDialects {
+			q := builder.New(d)
+			build := func(as string) (string, error) {
+				return q.Select(q.Column("id", option.As(as))).
+					From(person).
+					Where(q.Equal(q.Column("id"))).
+					BuildE(option.VariableFormat(d.BindType()))
+			}
+
+			actual, err := build(alias)
+			if err != nil {
+				checkEscapeError(t, d, alias, err)
+				continue
+			}
+			expected, _ := build("x")
+
+			if !strings.Contains(actual, " AS "+d.QuoteIdent(alias)+" ") {
+				t.Fatalf("%s: alias is not written as quoted identifier. Query = %s", d.Name(), actual)
+			}
+			compareSkeleton(t, d, actual, expected)
+		}
+	})
+}
+
+// FuzzJsonColumn asserts that JSON attributes are always written inside escaped string literal
+func FuzzJsonColumn(f *testing.F) {
+	for _, s := range escapeSeeds {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, path string) {
+		attrs := strings.Split(path, ".")
+		if attrs[0] == "" {
+			return
+		}
+
+		// Write path with the same count of plain attributes
+		plain := make([]string, len(attrs))
+		for i := range plain {
+			plain[i] = "x"
+		}
+
+		for _, d := range escapeDialects {
+			if !d.Supports(nsql.FeatureJSON) {
+				continue
+			}
+
+			q := builder.New(d)
+			build := func(p string) (query string, err error) {
+				defer nsql.RecoverBuildError(&err)
+				return q.Select(q.JsonColumn("content." + p)).From(content).Build(), nil
+			}
+
+			actual, err := build(path)
+			if err != nil {
+				checkEscapeError(t, d, path, err)
+				continue
+			}
+			expected, _ := build(strings.Join(plain, "."))
+
+			compareSkeleton(t, d, actual, expected)
+		}
+	})
+}
+
+func checkEscapeError(t *testing.T, d nsql.Dialect, input string, err error) {
+	// NUL character is rejected, and SQLite JSON path can not contain double quote
+	rejected := strings.Contains(input, "\x00") || d.Name() == "SQLite" && strings.Contains(input, `"`)
+	if !rejected || !errors.Is(err, nsql.ErrInvalidArgument) {
+		t.Fatalf("%s: unexpected error. Input = %q, Error = %s", d.Name(), input, err)
+	}
+}
+
+// compareSkeleton compare queries after removing quoted identifiers and string literals
+func compareSkeleton(t *testing.T, d nsql.Dialect, actual, expected string) {
+	a, e := skeleton(d, actual), skeleton(d, expected)
+	if a != e {
+		t.Fatalf("%s: query structure is changed\n  > expected = %s\n  > actual   = %s", d.Name(), e, a)
+	}
+}
+
+// skeleton replace quoted text with a placeholder character. Doubled quote is treated as escaped quote
+func skeleton(d nsql.Dialect, q string) string {
+	openQuote := d.QuoteIdent("")[0]
+	closeQuote := d.QuoteIdent("")[1]
+
+	var b strings.Builder
+	for i := 0; i < len(q); i++ {
+		c := q[i]
+		var end byte
+		switch c {
+		case openQuote:
+			end = closeQuote
+		case '\'':
+			end = '\''
+		default:
+			b.WriteByte(c)
+			continue
+		}
+
+		// Find closing quote
+		j := i + 1
+		for ; j < len(q); j++ {
+			if q[j] != end {
+				continue
+			}
+			if j+1 < len(q) && q[j+1] == end {
+				j++
+				continue
+			}
+			break
+		}
+		b.WriteByte('#')
+		i = j
+	}
+	return b.String()
+}
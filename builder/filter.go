@@ -1,4 +1,4 @@
-package query
+package builder
 
 import (
 	"fmt"
@@ -51,6 +51,11 @@ func NewFilter(qs map[string]string, funcMap map[string]nsql.FilterParser) *Filt
 			continue
 		}
 
+		// Bind arguments to condition, so arguments can be retrieved by BuildWithArgs
+		if cw, cOk := w.(*WhereCompareWriter); cOk && cw.args == nil && countBindVars(cw.variable) == len(args) {
+			cw.args = args
+		}
+
 		// Append condition
 		b.conditions = append(b.conditions, w)
 
@@ -63,7 +68,7 @@ func NewFilter(qs map[string]string, funcMap map[string]nsql.FilterParser) *Filt
 	return &b
 }
 
-func LikeFilter(col string, likeVar op.LikeVariable, args ...interface{}) nsql.FilterParser {
+func (q *Builder) LikeFilter(col string, likeVar op.LikeVariable, args ...interface{}) nsql.FilterParser {
 	// Get options
 	opts := option.EvaluateOptions(args)
 	s := opts.GetSchema()
@@ -79,20 +84,20 @@ func LikeFilter(col string, likeVar op.LikeVariable, args ...interface{}) nsql.F
 			qv = fmt.Sprintf(`%s%%`, qv)
 		}
 
-		w := Like(Column(col, option.Schema(s)), qv)
+		w := q.ILike(q.Column(col, option.Schema(s)), qv)
 
 		return w, []interface{}{qv}
 	}
 }
 
-func EqualFilter(s *schema.Schema, col string) nsql.FilterParser {
+func (q *Builder) EqualFilter(s *schema.Schema, col string) nsql.FilterParser {
 	return func(qv string) (nsql.WhereWriter, []interface{}) {
-		w := Equal(Column(col, option.Schema(s)))
+		w := q.Equal(q.Column(col, option.Schema(s)))
 		return w, []interface{}{qv}
 	}
 }
 
-func TimeGreaterThanEqualFilter(s *schema.Schema, col string, args ...string) nsql.FilterParser {
+func (q *Builder) TimeGreaterThanEqualFilter(s *schema.Schema, col string, args ...string) nsql.FilterParser {
 	return func(qv string) (nsql.WhereWriter, []interface{}) {
 		// Parse time
 		t, ok := qs.ParseTime(qv, args...)
@@ -101,12 +106,12 @@ func TimeGreaterThanEqualFilter(s *schema.Schema, col string, args ...string) ns
 		}
 
 		// Create schema
-		w := GreaterThanEqual(Column(col, option.Schema(s)))
+		w := q.GreaterThanEqual(q.Column(col, option.Schema(s)))
 		return w, []interface{}{t.UTC()}
 	}
 }
 
-func TimeLessThanEqualFilter(s *schema.Schema, col string, args ...string) nsql.FilterParser {
+func (q *Builder) TimeLessThanEqualFilter(s *schema.Schema, col string, args ...string) nsql.FilterParser {
 	return func(qv string) (nsql.WhereWriter, []interface{}) {
 		// Parse time
 		t, ok := qs.ParseTime(qv, args...)
@@ -114,12 +119,12 @@ func TimeLessThanEqualFilter(s *schema.Schema, col string, args ...string) nsql.
 			return nil, nil
 		}
 
-		w := LessThanEqual(Column(col, option.Schema(s)))
+		w := q.LessThanEqual(q.Column(col, option.Schema(s)))
 		return w, []interface{}{t.UTC()}
 	}
 }
 
-func IntGreaterThanEqualFilter(s *schema.Schema, col string) nsql.FilterParser {
+func (q *Builder) IntGreaterThanEqualFilter(s *schema.Schema, col string) nsql.FilterParser {
 	return func(qv string) (nsql.WhereWriter, []interface{}) {
 		// Parse int value
 		i, ok := qs.ParseInt(qv)
@@ -127,12 +132,12 @@ func IntGreaterThanEqualFilter(s *schema.Schema, col string) nsql.FilterParser {
 			return nil, nil
 		}
 
-		w := GreaterThanEqual(Column(col, option.Schema(s)))
+		w := q.GreaterThanEqual(q.Column(col, option.Schema(s)))
 		return w, []interface{}{i}
 	}
 }
 
-func IntLessThanEqualFilter(s *schema.Schema, col string) nsql.FilterParser {
+func (q *Builder) IntLessThanEqualFilter(s *schema.Schema, col string) nsql.FilterParser {
 	return func(qv string) (nsql.WhereWriter, []interface{}) {
 		// Parse int value
 		i, ok := qs.ParseInt(qv)
@@ -140,12 +145,12 @@ func IntLessThanEqualFilter(s *schema.Schema, col string) nsql.FilterParser {
 			return nil, nil
 		}
 
-		w := LessThanEqual(Column(col, option.Schema(s)))
+		w := q.LessThanEqual(q.Column(col, option.Schema(s)))
 		return w, []interface{}{i}
 	}
 }
 
-func FloatGreaterThanEqualFilter(s *schema.Schema, col string) nsql.FilterParser {
+func (q *Builder) FloatGreaterThanEqualFilter(s *schema.Schema, col string) nsql.FilterParser {
 	return func(qv string) (nsql.WhereWriter, []interface{}) {
 		// Parse float value
 		f, ok := qs.ParseFloat(qv)
@@ -153,12 +158,12 @@ func FloatGreaterThanEqualFilter(s *schema.Schema, col string) nsql.FilterParser
 			return nil, nil
 		}
 
-		w := GreaterThanEqual(Column(col, option.Schema(s)))
+		w := q.GreaterThanEqual(q.Column(col, option.Schema(s)))
 		return w, []interface{}{f}
 	}
 }
 
-func FloatLessThanEqualFilter(s *schema.Schema, col string) nsql.FilterParser {
+func (q *Builder) FloatLessThanEqualFilter(s *schema.Schema, col string) nsql.FilterParser {
 	return func(qv string) (nsql.WhereWriter, []interface{}) {
 		// Parse float value
 		f, ok := qs.ParseFloat(qv)
@@ -166,7 +171,7 @@ func FloatLessThanEqualFilter(s *schema.Schema, col string) nsql.FilterParser {
 			return nil, nil
 		}
 
-		w := LessThanEqual(Column(col, option.Schema(s)))
+		w := q.LessThanEqual(q.Column(col, option.Schema(s)))
 		return w, []interface{}{f}
 	}
 }
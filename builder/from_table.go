@@ -0,0 +1,98 @@
+package builder
+
+import (
+	"github.com/nbs-go/nsql"
+	"github.com/nbs-go/nsql/schema"
+	"strings"
+)
+
+func (q *Builder) newTableWriter(namespace string, tableName string, as string) *tableWriter {
+	return &tableWriter{
+		qb:        q,
+		namespace: namespace,
+		tableName: tableName,
+		as:        as,
+		joints:    map[schema.Reference]nsql.JoinWriter{},
+	}
+}
+
+type tableWriter struct {
+	qb        *Builder
+	namespace string
+	tableName string
+	as        string
+	joints    map[schema.Reference]nsql.JoinWriter
+}
+
+func (s *tableWriter) GetSchemaRef() schema.Reference {
+	if s.as != "" {
+		return schema.Reference(s.as)
+	}
+	return schema.Reference(s.tableName)
+}
+
+func (s *tableWriter) Join(j nsql.JoinWriter) {
+	// Set join index
+	idx := len(s.joints)
+	j.SetIndex(idx)
+
+	// Add joints
+	s.joints[j.GetSchemaRef()] = j
+}
+
+func (s *tableWriter) GetTableName() string {
+	return s.tableName
+}
+
+func (s *tableWriter) FromQuery() string {
+	return writeQuery(s.WriteFromQuery)
+}
+
+func (s *tableWriter) WriteFromQuery(sb *strings.Builder) {
+	s.writeQuery(sb, "")
+}
+
+// writeQuery write FROM clause. If namespace is set, then it will override namespace of tables in FROM and JOIN
+func (s *tableWriter) writeQuery(sb *strings.Builder, namespace string) {
+	s.qb.writeTable(sb, namespace, s.namespace, s.tableName)
+
+	if s.as != "" {
+		s.qb.writeAs(sb, s.as)
+	}
+
+	jointCount := len(s.joints)
+	if jointCount == 0 {
+		return
+	}
+
+	// Sort joints by index
+	joints := make([]nsql.JoinWriter, jointCount)
+	for _, jw := range s.joints {
+		joints[jw.GetIndex()] = jw
+	}
+
+	for _, jw := range joints {
+		sb.WriteByte(' ')
+		if j, ok := jw.(*joinWriter); ok {
+			j.writeQuery(sb, namespace)
+			continue
+		}
+		jw.WriteJoinQuery(sb)
+	}
+}
+
+// GetArgs returns values that are bound in JOIN conditions in order of joins
+func (s *tableWriter) GetArgs() []interface{} {
+	joints := make([]nsql.JoinWriter, len(s.joints))
+	for _, jw := range s.joints {
+		joints[jw.GetIndex()] = jw
+	}
+
+	var args []interface{}
+	for _, jw := range joints {
+		if ag, ok := jw.(nsql.ArgsGetter); ok {
+			args = append(args, ag.GetArgs()...)
+		}
+	}
+	return args
+}
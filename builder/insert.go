@@ -0,0 +1,360 @@
+package builder
+
+import (
+	"fmt"
+	"github.com/nbs-go/nsql"
+	"github.com/nbs-go/nsql/op"
+	"github.com/nbs-go/nsql/option"
+	"github.com/nbs-go/nsql/schema"
+	"strings"
+)
+
+type InsertBuilder struct {
+	qb        *Builder
+	schema    *schema.Schema
+	tableName string
+	columns   []string
+	format    op.ColumnFormat
+	dropped   droppedParts
+	pk        string
+	values    valueMap
+	conflict  *onConflict
+	err       error
+}
+
+// onConflict is an ON CONFLICT clause of INSERT query
+type onConflict struct {
+	columns   []string
+	update    []string
+	doNothing bool
+}
+
+// Build write query with named variables. Set option.VariableFormat to write bind variables as ? or positional
+// placeholders
+func (b *InsertBuilder) Build(args ...interface{}) string {
+	opts := option.EvaluateOptions(args)
+	format, ok := opts.GetVariableFormat()
+	if !ok {
+		format = b.qb.defaultFormat(op.NamedVar)
+	}
+	if isPositional(format) {
+		b.qb.checkPositional(format)
+	}
+	return b.build(format, namespaceOption(args))
+}
+
+// Values set values of inserted columns in order of columns that are declared in Insert. If all columns are inserted,
+// then values must be in order of schema.Schema InsertColumns
+func (b *InsertBuilder) Values(v ...interface{}) *InsertBuilder {
+	b.values.values = v
+	return b
+}
+
+// OnConflict write ON CONFLICT clause with conflict target columns. Set action with DoUpdate or DoNothing, default to
+// DO NOTHING. Dialect that supports nsql.FeatureMerge write it as MERGE statement. It will panic if column is not
+// declared in schema. If dialect does not support upsert, then Build will panic and BuildE will return the error
+func (b *InsertBuilder) OnConflict(column string, columnN ...string) *InsertBuilder {
+	columns := append([]string{column}, columnN...)
+	b.checkColumns(columns)
+
+	// Reject upsert if dialect does not support it
+	d := b.qb.dialect
+	if !d.Supports(nsql.FeatureUpsert) && !d.Supports(nsql.FeatureMerge) && b.err == nil {
+		b.err = nsql.NewBuildError(nsql.ErrInvalidArgument, "ON CONFLICT clause is not supported by %s", d.Name())
+	}
+
+	b.conflict = &onConflict{columns: columns, doNothing: true}
+	return b
+}
+
+// DoNothing set action of ON CONFLICT clause to skip inserting conflicted row
+func (b *InsertBuilder) DoNothing() *InsertBuilder {
+	b.mustConflict("DoNothing")
+	b.conflict.doNothing = true
+	b.conflict.update = nil
+	return b
+}
+
+// DoUpdate set action of ON CONFLICT clause to update columns with inserted values. If columns is empty, then all
+// inserted columns except conflict target are updated
+func (b *InsertBuilder) DoUpdate(columns ...string) *InsertBuilder {
+	b.mustConflict("DoUpdate")
+	b.checkColumns(columns)
+
+	// Set default columns
+	if len(columns) == 0 {
+		for _, c := range b.columns {
+			if !containsString(b.conflict.columns, c) {
+				columns = append(columns, c)
+			}
+		}
+	}
+
+	b.conflict.doNothing = false
+	b.conflict.update = columns
+	return b
+}
+
+// BuildWithArgs build query with bind variables and returns values that are set by Values in order of placeholders.
+// Values of columns that are not declared in schema are dropped
+func (b *InsertBuilder) BuildWithArgs(args ...interface{}) (string, []interface{}) {
+	values := b.values.args("INSERT")
+	return b.build(b.qb.bindVarFormat(args), namespaceOption(args)), values
+}
+
+func (b *InsertBuilder) build(format op.VariableFormat, namespace string) string {
+	// If query has invalid declaration, then panic
+	if b.err != nil {
+		panic(b.err)
+	}
+
+	// If no column is defined, then panic
+	if len(b.columns) == 0 {
+		panic(nsql.NewBuildError(nsql.ErrNoColumns, `no column defined on insert table "%s"`, b.tableName))
+	}
+
+	d := b.qb.dialect
+	var sb strings.Builder
+
+	// Write upsert as MERGE statement
+	if b.conflict != nil && d.Supports(nsql.FeatureMerge) {
+		b.writeMerge(&sb, format, namespace)
+		return sb.String()
+	}
+
+	// Write columns
+	sb.WriteString("INSERT INTO ")
+	b.qb.writeTable(&sb, namespace, b.schema.Namespace(), b.tableName)
+	sb.WriteByte('(')
+	b.writeColumns(&sb, "")
+	sb.WriteByte(')')
+
+	// Write output of primary key before values
+	outputInserted := b.pk != "" && d.Supports(nsql.FeatureOutputInserted)
+	if outputInserted {
+		sb.WriteString(" OUTPUT INSERTED.")
+		sb.WriteString(d.QuoteIdent(b.pk))
+	}
+
+	// Write values
+	sb.WriteString(" VALUES (")
+	b.writeValues(&sb, format)
+	sb.WriteByte(')')
+
+	// Write on conflict
+	b.writeOnConflict(&sb)
+
+	// Write returning
+	if b.pk != "" && !outputInserted && d.Supports(nsql.FeatureReturning) {
+		sb.WriteString(" RETURNING ")
+		sb.WriteString(d.QuoteIdent(b.pk))
+	}
+
+	return sb.String()
+}
+
+// writeColumns write inserted columns, each column is prefixed with table reference if prefix is set
+func (b *InsertBuilder) writeColumns(sb *strings.Builder, prefix string) {
+	for i, c := range b.columns {
+		if i > 0 {
+			sb.WriteString(nsql.Separator)
+		}
+		sb.WriteString(prefix)
+		sb.WriteString(b.qb.dialect.QuoteIdent(c))
+	}
+}
+
+// writeValues write variables of inserted columns
+func (b *InsertBuilder) writeValues(sb *strings.Builder, format op.VariableFormat) {
+	for i, c := range b.columns {
+		if i > 0 {
+			sb.WriteString(nsql.Separator)
+		}
+		switch format {
+		case op.BindVar, op.DollarVar, op.AtPVar:
+			nsql.WritePlaceholder(sb, format, i+1)
+		default:
+			sb.WriteByte(':')
+			sb.WriteString(c)
+		}
+	}
+}
+
+// writeMerge write INSERT query with ON CONFLICT declaration as MERGE statement. Inserted values are declared as source
+// table, then matched by conflict target columns
+func (b *InsertBuilder) writeMerge(sb *strings.Builder, format op.VariableFormat, namespace string) {
+	d := b.qb.dialect
+	target, source := d.QuoteIdent("t"), d.QuoteIdent("s")
+
+	// Write source table
+	sb.WriteString("MERGE INTO ")
+	b.qb.writeTable(sb, namespace, b.schema.Namespace(), b.tableName)
+	sb.WriteString(" WITH (HOLDLOCK) AS ")
+	sb.WriteString(target)
+	sb.WriteString(" USING (VALUES (")
+	b.writeValues(sb, format)
+	sb.WriteString(")) AS ")
+	sb.WriteString(source)
+	sb.WriteByte('(')
+	b.writeColumns(sb, "")
+	sb.WriteByte(')')
+
+	// Write merge condition
+	sb.WriteString(" ON ")
+	b.writeMergeColumns(sb, b.conflict.columns, target, source, " AND ")
+
+	// Write update action
+	if !b.conflict.doNothing && len(b.conflict.update) > 0 {
+		sb.WriteString(" WHEN MATCHED THEN UPDATE SET ")
+		b.writeMergeColumns(sb, b.conflict.update, target, source, nsql.Separator)
+	}
+
+	// Write insert action
+	sb.WriteString(" WHEN NOT MATCHED THEN INSERT (")
+	b.writeColumns(sb, "")
+	sb.WriteString(") VALUES (")
+	b.writeColumns(sb, source+".")
+	sb.WriteByte(')')
+
+	// Write inserted primary key
+	if b.pk != "" && d.Supports(nsql.FeatureOutputInserted) {
+		sb.WriteString(" OUTPUT INSERTED.")
+		sb.WriteString(d.QuoteIdent(b.pk))
+	}
+
+	// MERGE statement must be terminated by semicolon
+	sb.WriteByte(';')
+}
+
+// writeMergeColumns write columns of target table that are compared or assigned with columns of source table
+func (b *InsertBuilder) writeMergeColumns(sb *strings.Builder, columns []string, target, source, separator string) {
+	for i, c := range columns {
+		if i > 0 {
+			sb.WriteString(separator)
+		}
+		col := b.qb.dialect.QuoteIdent(c)
+		sb.WriteString(target)
+		sb.WriteByte('.')
+		sb.WriteString(col)
+		sb.WriteString(" = ")
+		sb.WriteString(source)
+		sb.WriteByte('.')
+		sb.WriteString(col)
+	}
+}
+
+func (b *InsertBuilder) writeOnConflict(sb *strings.Builder) {
+	if b.conflict == nil {
+		return
+	}
+
+	d := b.qb.dialect
+
+	// Write conflict target
+	sb.WriteString(" ON CONFLICT (")
+	for i, c := range b.conflict.columns {
+		if i > 0 {
+			sb.WriteString(nsql.Separator)
+		}
+		sb.WriteString(d.QuoteIdent(c))
+	}
+	sb.WriteByte(')')
+
+	if b.conflict.doNothing || len(b.conflict.update) == 0 {
+		sb.WriteString(" DO NOTHING")
+		return
+	}
+
+	// Write assignments with inserted values
+	sb.WriteString(" DO UPDATE SET ")
+	for i, c := range b.conflict.update {
+		if i > 0 {
+			sb.WriteString(nsql.Separator)
+		}
+		col := d.QuoteIdent(c)
+		sb.WriteString(col)
+		sb.WriteString(" = excluded.")
+		sb.WriteString(col)
+	}
+}
+
+func (b *InsertBuilder) mustConflict(method string) {
+	if b.conflict == nil {
+		panic(nsql.NewBuildError(nsql.ErrInvalidArgument, "%s must be called after OnConflict", method))
+	}
+}
+
+// checkColumns panic if column is not declared in schema
+func (b *InsertBuilder) checkColumns(columns []string) {
+	for _, c := range columns {
+		if !b.schema.IsColumnExist(c) {
+			panic(nsql.NewBuildError(nsql.ErrUnknownColumn, `column "%s" is not declared in schema "%s"`, c,
+				b.tableName))
+		}
+	}
+}
+
+func containsString(arr []string, s string) bool {
+	for _, v := range arr {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// BuildE build query and returns nsql.BuildError instead of panic on invalid query declaration. In strict mode that is
+// enabled by option.Strict or nsql.SetStrictMode, it will return nsql.DroppedError instead of dropping undeclared
+// columns silently
+func (b *InsertBuilder) BuildE(args ...interface{}) (q string, err error) {
+	defer nsql.RecoverBuildError(&err)
+
+	opts := option.EvaluateOptions(args)
+	if err := b.dropped.err(opts); err != nil {
+		return "", err
+	}
+	return b.Build(args...), nil
+}
+
+func (q *Builder) Insert(s *schema.Schema, column string, columnN ...string) *InsertBuilder {
+	// Init builder
+	b := InsertBuilder{
+		qb:        q,
+		schema:    s,
+		tableName: s.TableName(),
+		pk:        s.PrimaryKey(),
+	}
+
+	var columns []string
+	if column == AllColumns {
+		// Get all columns
+		columns = s.InsertColumns()
+		b.values.declare(len(columns))
+		for i := range columns {
+			b.values.use(i)
+		}
+	} else {
+		inColumns := append([]string{column}, columnN...)
+		b.values.declare(len(inColumns))
+		for i, c := range inColumns {
+			if !s.IsColumnExist(c) {
+				b.dropped.parts = append(b.dropped.parts,
+					fmt.Sprintf(`INSERT column "%s" is not declared in schema "%s"`, c, s.TableName()))
+				continue
+			}
+			columns = append(columns, c)
+			b.values.use(i)
+		}
+	}
+
+	// Set columns
+	b.columns = columns
+
+	return &b
+}
+
+// InsertCol create InsertBuilder from typed column references
+func (q *Builder) InsertCol(ref1 schema.ColumnRef, refN ...schema.ColumnRef) *InsertBuilder {
+	s, columns := resolveColumnRefs(ref1, refN)
+	return q.Insert(s, columns[0], columns[1:]...)
+}
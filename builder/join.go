@@ -0,0 +1,75 @@
+package builder
+
+import (
+	"github.com/nbs-go/nsql"
+	"github.com/nbs-go/nsql/op"
+	"github.com/nbs-go/nsql/schema"
+	"strings"
+)
+
+type joinWriter struct {
+	qb          *Builder
+	method      op.JoinMethod
+	table       *schema.Schema
+	onCondition nsql.WhereWriter
+	index       int
+}
+
+func (j *joinWriter) GetSchemaRef() schema.Reference {
+	return j.table.Ref()
+}
+
+func (j *joinWriter) GetIndex() int {
+	return j.index
+}
+
+func (j *joinWriter) SetIndex(n int) {
+	j.index = n
+}
+
+func (j *joinWriter) GetTableName() string {
+	return j.table.TableName()
+}
+
+func (j *joinWriter) JoinQuery() string {
+	return writeQuery(j.WriteJoinQuery)
+}
+
+func (j *joinWriter) WriteJoinQuery(sb *strings.Builder) {
+	j.writeQuery(sb, "")
+}
+
+// writeQuery write JOIN clause. If namespace is set, then it will override namespace of joined table
+func (j *joinWriter) writeQuery(sb *strings.Builder, namespace string) {
+	// Write method query
+	switch j.method {
+	case op.InnerJoin:
+		sb.WriteString("INNER JOIN ")
+	case op.RightJoin:
+		sb.WriteString("RIGHT JOIN ")
+	case op.FullJoin:
+		sb.WriteString("FULL OUTER JOIN ")
+	default:
+		// Default to left join
+		sb.WriteString("LEFT JOIN ")
+	}
+
+	// Write table name
+	table := j.table
+	j.qb.writeTable(sb, namespace, table.Namespace(), table.TableName())
+	if table.As() != "" {
+		j.qb.writeAs(sb, table.As())
+	}
+
+	// Write condition
+	sb.WriteString(" ON ")
+	j.onCondition.WriteWhereQuery(sb)
+}
+
+// GetArgs returns values that are bound in ON condition
+func (j *joinWriter) GetArgs() []interface{} {
+	if ag, ok := j.onCondition.(nsql.ArgsGetter); ok {
+		return ag.GetArgs()
+	}
+	return nil
+}
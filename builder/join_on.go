@@ -1,8 +1,8 @@
-package query
+package builder
 
 import "github.com/nbs-go/nsql/option"
 
-func On(col string, args ...interface{}) option.SetOptionFn {
+func (q *Builder) On(col string, args ...interface{}) option.SetOptionFn {
 	return func(o *option.Options) {
 		// Evaluate options
 		opts := option.EvaluateOptions(args)
@@ -15,7 +15,8 @@ func On(col string, args ...interface{}) option.SetOptionFn {
 		}
 
 		// Set variable
-		o.KV[option.VariableKey] = &columnWriter{
+		o.KV[option.VariableKey] = &ColumnWriter{
+			qb:        q,
 			name:      col,
 			tableName: tableName,
 		}
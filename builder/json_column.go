@@ -1,8 +1,7 @@
-package query
+package builder
 
 import (
-	"errors"
-	"fmt"
+	"github.com/nbs-go/nsql"
 	"github.com/nbs-go/nsql/op"
 	"github.com/nbs-go/nsql/option"
 	"github.com/nbs-go/nsql/schema"
@@ -11,6 +10,7 @@ import (
 
 // JsonColumnWriter implements query.SelectWriter for a column that referred to a JSON
 type JsonColumnWriter struct {
+	qb        *Builder
 	name      string
 	attrs     []string
 	tableName string
@@ -25,15 +25,19 @@ func (w *JsonColumnWriter) GetSchemaRef() schema.Reference {
 	return schema.Reference(w.tableName)
 }
 
-func JsonColumn(column string, args ...interface{}) *JsonColumnWriter {
+func (q *Builder) JsonColumn(column string, args ...interface{}) *JsonColumnWriter {
+	if !q.dialect.Supports(nsql.FeatureJSON) {
+		panic(nsql.NewBuildError(nsql.ErrInvalidArgument, "nsql: JsonColumn is not supported by %s", q.dialect.Name()))
+	}
+
 	// If column does not contain ".", then panic
 	tmp := strings.Split(column, ".")
 	if len(tmp) < 2 {
-		panic(errors.New(`nsql: invalid JsonColumn value, attributes is not defined`))
+		panic(nsql.NewBuildError(nsql.ErrInvalidArgument, `nsql: invalid JsonColumn value, attributes is not defined`))
 	}
 
 	if tmp[1] == "" {
-		panic(errors.New(`nsql: invalid JsonColumn value, attributes is not defined`))
+		panic(nsql.NewBuildError(nsql.ErrInvalidArgument, `nsql: invalid JsonColumn value, attributes is not defined`))
 	}
 
 	// Set columns on the first index, the rest refer to attributes
@@ -56,6 +60,7 @@ func JsonColumn(column string, args ...interface{}) *JsonColumnWriter {
 	as, _ := opts.GetString(option.AsKey)
 
 	return &JsonColumnWriter{
+		qb:        q,
 		name:      col,
 		attrs:     attrs,
 		tableName: tableName,
@@ -80,7 +85,15 @@ func (w *JsonColumnWriter) SetSchema(s *schema.Schema) {
 }
 
 func (w *JsonColumnWriter) ColumnQuery() string {
-	return w.query()
+	return writeQuery(w.WriteColumnQuery)
+}
+
+func (w *JsonColumnWriter) WriteColumnQuery(sb *strings.Builder) {
+	w.write(sb, nil)
+}
+
+func (w *JsonColumnWriter) writeColumnState(sb *strings.Builder, st *buildState) {
+	w.write(sb, st)
 }
 
 func (w *JsonColumnWriter) IsAllColumns() bool {
@@ -88,11 +101,23 @@ func (w *JsonColumnWriter) IsAllColumns() bool {
 }
 
 func (w *JsonColumnWriter) SelectQuery() string {
-	q := w.query()
-	if w.as != "" {
-		q = fmt.Sprintf(`(%s) AS "%s"`, q, w.as)
+	return writeQuery(w.WriteSelectQuery)
+}
+
+func (w *JsonColumnWriter) WriteSelectQuery(sb *strings.Builder) {
+	w.writeSelectState(sb, nil)
+}
+
+func (w *JsonColumnWriter) writeSelectState(sb *strings.Builder, st *buildState) {
+	if w.as == "" {
+		w.write(sb, st)
+		return
 	}
-	return q
+
+	sb.WriteByte('(')
+	w.write(sb, st)
+	sb.WriteByte(')')
+	w.qb.writeAs(sb, w.as)
 }
 
 func (w *JsonColumnWriter) GetTableName() string {
@@ -111,26 +136,32 @@ func (w *JsonColumnWriter) SetFormat(_ op.ColumnFormat) {
 	// Do nothing
 }
 
-// query Generate column query
-func (w *JsonColumnWriter) query() string {
+// write Generate column query
+func (w *JsonColumnWriter) write(sb *strings.Builder, st *buildState) {
 	// Set table alias
-	tableName := w.tableName
-	if w.tableAs != "" {
-		tableName = w.tableAs
+	tableName, tableAs := st.resolve(w, w.tableName, w.tableAs)
+	if tableAs != "" {
+		tableName = tableAs
 	}
 
-	// Write attribute query
-	var aq string
-	attrsCount := len(w.attrs)
-	if attrsCount == 1 {
-		aq = "->>'" + w.attrs[0] + "'"
-	} else {
-		// Pop leaf from attrs
-		leaf, attrs := w.attrs[attrsCount-1], w.attrs[:attrsCount-1]
-		// Write attribute query
-		aq = "->'" + strings.Join(attrs, "'->'") + "'->>'" + leaf + "'"
+	quote := w.qb.dialect.QuoteIdent
+	column := quote(tableName) + "." + quote(w.name)
+
+	// If dialect has JSON function, then extract with function
+	if je, ok := w.qb.dialect.(nsql.JSONExtractor); ok {
+		sb.WriteString(je.JSONExtract(column, w.attrs))
+		return
 	}
 
-	// Write column query
-	return fmt.Sprintf(`"%s"."%s"%s`, tableName, w.name, aq)
+	// Write attribute query, attributes are written as escaped string literal
+	sb.WriteString(column)
+	last := len(w.attrs) - 1
+	for i, attr := range w.attrs {
+		if i == last {
+			sb.WriteString("->>")
+		} else {
+			sb.WriteString("->")
+		}
+		sb.WriteString(w.qb.dialect.QuoteLiteral(attr))
+	}
 }
@@ -0,0 +1,77 @@
+package builder
+
+import (
+	"github.com/nbs-go/nsql"
+	"github.com/nbs-go/nsql/option"
+	"strings"
+)
+
+func (q *Builder) Lower(col nsql.ColumnWriter, args ...interface{}) *LowerColumnWriter {
+	if col == nil {
+		panic(nsql.NewBuildError(nsql.ErrInvalidArgument, "nsql: column cannot be nil"))
+	}
+
+	if col.GetColumn() == AllColumns {
+		panic(nsql.NewBuildError(nsql.ErrInvalidArgument, "nsql: all column (*) is not supported"))
+	}
+
+	// Evaluate options
+	opts := option.EvaluateOptions(args)
+
+	// Get alias
+	as, _ := opts.GetString(option.AsKey)
+
+	return &LowerColumnWriter{
+		qb:           q,
+		as:           as,
+		ColumnWriter: col,
+	}
+}
+
+// LowerColumnWriter implements query.SelectWriter that wrap column with LOWER() function
+type LowerColumnWriter struct {
+	nsql.ColumnWriter
+	qb *Builder
+	as string
+}
+
+func (c *LowerColumnWriter) SelectQuery() string {
+	return writeQuery(c.WriteSelectQuery)
+}
+
+func (c *LowerColumnWriter) WriteSelectQuery(sb *strings.Builder) {
+	c.writeSelectState(sb, nil)
+}
+
+func (c *LowerColumnWriter) writeSelectState(sb *strings.Builder, st *buildState) {
+	sb.WriteString("LOWER(")
+	writeColumnState(sb, c.ColumnWriter, st)
+	sb.WriteByte(')')
+
+	if c.as != "" {
+		c.qb.writeAs(sb, c.as)
+	}
+}
+
+func (c *LowerColumnWriter) ColumnQuery() string {
+	return writeQuery(c.WriteColumnQuery)
+}
+
+func (c *LowerColumnWriter) WriteColumnQuery(sb *strings.Builder) {
+	c.writeColumnState(sb, nil)
+}
+
+func (c *LowerColumnWriter) writeColumnState(sb *strings.Builder, st *buildState) {
+	if c.as != "" {
+		sb.WriteString(c.qb.dialect.QuoteIdent(c.as))
+		return
+	}
+
+	sb.WriteString("LOWER(")
+	writeColumnState(sb, c.ColumnWriter, st)
+	sb.WriteByte(')')
+}
+
+func (c *LowerColumnWriter) IsAllColumns() bool {
+	return false
+}
@@ -0,0 +1,29 @@
+package builder
+
+import (
+	"github.com/nbs-go/nsql"
+	"github.com/nbs-go/nsql/op"
+	"strings"
+)
+
+type orderByWriter struct {
+	nsql.ColumnWriter
+	direction op.SortDirection
+}
+
+func (o *orderByWriter) OrderByQuery() string {
+	return writeQuery(o.WriteOrderByQuery)
+}
+
+func (o *orderByWriter) WriteOrderByQuery(sb *strings.Builder) {
+	o.writeOrderByState(sb, nil)
+}
+
+func (o *orderByWriter) writeOrderByState(sb *strings.Builder, st *buildState) {
+	writeColumnState(sb, o.ColumnWriter, st)
+	if o.direction == op.Descending {
+		sb.WriteString(" DESC")
+	} else {
+		sb.WriteString(" ASC")
+	}
+}
@@ -0,0 +1,58 @@
+package builder
+
+import (
+	"github.com/nbs-go/nsql"
+	"github.com/nbs-go/nsql/op"
+	"github.com/nbs-go/nsql/option"
+)
+
+// isPositional returns true if variable format is written as numbered placeholders, e.g. $1 or @p1
+func isPositional(format op.VariableFormat) bool {
+	return format == op.DollarVar || format == op.AtPVar
+}
+
+// formatBindVars rebind query if positional variable format is set in variable format option
+func (q *Builder) formatBindVars(query string, args []interface{}) string {
+	if format := q.bindVarFormat(args); isPositional(format) {
+		return nsql.Rebind(format, query)
+	}
+	return query
+}
+
+// bindVarFormat returns variable format for building query with arguments. Default to op.BindVar
+func (q *Builder) bindVarFormat(args []interface{}) op.VariableFormat {
+	opts := option.EvaluateOptions(args)
+	f, ok := opts.GetVariableFormat()
+	if !ok {
+		return q.defaultFormat(op.BindVar)
+	}
+	if isPositional(f) {
+		q.checkPositional(f)
+		return f
+	}
+	return op.BindVar
+}
+
+// defaultFormat returns variable format that is used if format is not set by option. If dialect supports only
+// positional placeholders, then returns its BindType
+func (q *Builder) defaultFormat(format op.VariableFormat) op.VariableFormat {
+	if q.dialect.Supports(nsql.FeaturePositionalVar) {
+		return q.dialect.BindType()
+	}
+	return format
+}
+
+// checkPositional panic if positional placeholders format is not supported by dialect
+func (q *Builder) checkPositional(format op.VariableFormat) {
+	if q.dialect.BindType() != format {
+		panic(nsql.NewBuildError(nsql.ErrInvalidArgument, "op.%s variable format is not supported by %s",
+			positionalName(format), q.dialect.Name()))
+	}
+}
+
+func positionalName(format op.VariableFormat) string {
+	if format == op.AtPVar {
+		return "AtPVar"
+	}
+	return "DollarVar"
+}
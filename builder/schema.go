@@ -0,0 +1,80 @@
+package builder
+
+import (
+	"github.com/nbs-go/nsql"
+	"github.com/nbs-go/nsql/op"
+	"github.com/nbs-go/nsql/option"
+	"github.com/nbs-go/nsql/schema"
+)
+
+func (q *Builder) Schema(s *schema.Schema) *SchemaBuilder {
+	return &SchemaBuilder{
+		qb:     q,
+		schema: s,
+	}
+}
+
+type SchemaBuilder struct {
+	qb     *Builder
+	schema *schema.Schema
+}
+
+func (s *SchemaBuilder) Schema() *schema.Schema {
+	return s.schema
+}
+
+func (s *SchemaBuilder) FindByPK(args ...interface{}) string {
+	q := s.qb
+	return q.Select(q.Column("*")).From(s.schema).Where(q.Equal(q.Column(s.schema.PrimaryKey()))).Build(args...)
+}
+
+func (s *SchemaBuilder) Insert(args ...interface{}) string {
+	return s.qb.Insert(s.schema, AllColumns).Build(args...)
+}
+
+func (s *SchemaBuilder) Update(args ...interface{}) string {
+	q := s.qb
+	where := q.Equal(q.Column(s.schema.PrimaryKey()))
+	return q.Update(s.schema, AllColumns).Where(where).Build(args...)
+}
+
+func (s *SchemaBuilder) Delete(args ...interface{}) string {
+	q := s.qb
+	where := q.Equal(q.Column(s.schema.PrimaryKey()))
+	return q.Delete(s.schema).Where(where).Build(args...)
+}
+
+func (s *SchemaBuilder) Count(where nsql.WhereWriter, args ...interface{}) string {
+	q := s.qb
+	return q.Select(q.Count(s.schema.PrimaryKey(), option.As("count"))).From(s.schema).Where(where).Build(args...)
+}
+
+// List returns query to select all columns with conditions. If where is nil, then all rows will be selected
+func (s *SchemaBuilder) List(where nsql.WhereWriter, args ...interface{}) string {
+	b := s.qb.Select(s.qb.Column("*")).From(s.schema)
+	if where != nil {
+		b.Where(where)
+	}
+	return b.Build(args...)
+}
+
+// ReturnsPrimaryKey returns true if Insert query returns generated primary key as a row, that is written with RETURNING
+// or OUTPUT INSERTED clause by dialect
+func (s *SchemaBuilder) ReturnsPrimaryKey() bool {
+	if s.schema.PrimaryKey() == "" {
+		return false
+	}
+	d := s.qb.dialect
+	return d.Supports(nsql.FeatureReturning) || d.Supports(nsql.FeatureOutputInserted)
+}
+
+// BindType returns variable format that is used by database driver of dialect
+func (s *SchemaBuilder) BindType() op.VariableFormat {
+	return s.qb.dialect.BindType()
+}
+
+func (s *SchemaBuilder) IsExists(where nsql.WhereWriter, args ...interface{}) string {
+	q := s.qb
+	return q.Select(q.GreaterThan(q.Count(s.schema.PrimaryKey()), IntVar(0), option.As("isExists"))).
+		From(s.schema).Where(where).Build(args...)
+}
@@ -0,0 +1,453 @@
+package builder
+
+import (
+	"github.com/nbs-go/nsql"
+	"github.com/nbs-go/nsql/op"
+	"github.com/nbs-go/nsql/option"
+	"github.com/nbs-go/nsql/schema"
+	"log"
+	"strings"
+)
+
+func (q *Builder) newSelectBuilder() *SelectBuilder {
+	return &SelectBuilder{
+		qb:        q,
+		fields:    []nsql.SelectWriter{},
+		orderBys:  []nsql.OrderByWriter{},
+		schemaRef: map[schema.Reference]*schema.Schema{},
+	}
+}
+
+func (q *Builder) Select(column1 nsql.SelectWriter, columnN ...nsql.SelectWriter) *SelectBuilder {
+	b := q.newSelectBuilder()
+	b.Select(column1, columnN...)
+	return b
+}
+
+func (q *Builder) From(s *schema.Schema, args ...interface{}) *SelectBuilder {
+	b := q.newSelectBuilder()
+	b.From(s, args...)
+	return b
+}
+
+type SelectBuilder struct {
+	qb        *Builder
+	fields    []nsql.SelectWriter
+	from      nsql.FromWriter
+	where     nsql.WhereWriter
+	orderBys  []nsql.OrderByWriter
+	limit     *int64
+	skip      *int64
+	schemaRef map[schema.Reference]*schema.Schema
+	dropped   droppedParts
+	err       error
+}
+
+func (b *SelectBuilder) Select(column1 nsql.SelectWriter, columnN ...nsql.SelectWriter) *SelectBuilder {
+	// Set existing
+	b.fields = append([]nsql.SelectWriter{column1}, columnN...)
+	return b
+}
+
+func (b *SelectBuilder) From(s *schema.Schema, args ...interface{}) *SelectBuilder {
+	// Resolve as option, and print warning
+	opts := option.EvaluateOptions(args)
+	as, _ := opts.GetString(option.AsKey)
+	if as != "" {
+		log.Printf("nsql: warning: From() option setter option.As() is deprecated. Use schema.New() option setter schema.As() instead. See Breaking Changes Note => https://github.com/nbs-go/nsql#breaking-changes. (Schema = %s)\n", s.TableName())
+	}
+	// Create writer
+	w := b.qb.newTableWriter(s.Namespace(), s.TableName(), s.As())
+	// Add table and set FROM
+	b.addTable(s)
+	b.from = w
+	return b
+}
+
+func (b *SelectBuilder) Join(s *schema.Schema, onCondition nsql.WhereWriter, args ...interface{}) *SelectBuilder {
+	// If FROM is not declared, then error is deferred to Build
+	if b.from == nil {
+		if b.err == nil {
+			b.err = nsql.NewBuildError(nsql.ErrUnknownTable, `FROM table must be declared before joining table "%s"`,
+				s.TableName())
+		}
+		return b
+	}
+
+	// Evaluate options
+	opts := option.EvaluateOptions(args)
+	joinMethod := opts.GetJoinMethod()
+
+	// Resolve as option, and print warning
+	as, _ := opts.GetString(option.AsKey)
+	if as != "" {
+		log.Printf("nsql: warning: Join() option setter option.As() is deprecated. Use schema.New() option setter schema.As() instead. See Breaking Changes Note => https://github.com/nbs-go/nsql#breaking-changes. (Schema = %s)\n", s.TableName())
+	}
+
+	// Add table
+	b.addTable(s)
+
+	// Resolve fromTableFlag reference
+	resolveFromTableFlag(onCondition, b.getFromSchema())
+
+	// Resolve joinTableFlag reference
+	resolveJoinTableFlag(onCondition, s)
+
+	// Collect conditions that is skipped
+	collectSkippedConditions(onCondition, "JOIN", &b.dropped)
+
+	// Set table aliases. Error on invalid condition is deferred to Build, so it can be returned by BuildE
+	joinTable := s
+	if err := b.setJoinTableAs(onCondition, joinTable); err != nil && b.err == nil {
+		b.err = err
+	}
+
+	// Create join writer
+	var w = joinWriter{
+		qb:          b.qb,
+		method:      joinMethod,
+		table:       joinTable,
+		onCondition: onCondition,
+	}
+
+	// Set join
+	b.from.Join(&w)
+
+	return b
+}
+
+func (b *SelectBuilder) Where(w1 nsql.WhereWriter, wn ...nsql.WhereWriter) *SelectBuilder {
+	// If wn is not set, then set where filter
+	if len(wn) == 0 {
+		b.where = w1
+		return b
+	}
+
+	// Else, set where with AND logical operators
+	where := append([]nsql.WhereWriter{w1}, wn...)
+	b.where = &WhereLogicWriter{
+		op:         op.And,
+		conditions: where,
+	}
+	return b
+}
+
+func (b *SelectBuilder) OrderBy(col string, args ...interface{}) *SelectBuilder {
+	// Evaluate options
+	opts := option.EvaluateOptions(args)
+
+	// Get schema
+	s := opts.GetSchema()
+
+	var tableName, tableAs string
+	if s != nil {
+		// If column is not available, then mark column to be skipped from writer
+		if !s.IsColumnExist(col) {
+			tableName = skipTableFlag
+		} else {
+			tableName = s.TableName()
+			tableAs = s.As()
+		}
+	} else {
+		tableName = fromTableFlag
+	}
+
+	// Get direction
+	direction := opts.GetSortDirection()
+
+	b.orderBys = append(b.orderBys, &orderByWriter{
+		ColumnWriter: &ColumnWriter{
+			qb:        b.qb,
+			name:      col,
+			tableName: tableName,
+			tableAs:   tableAs,
+		},
+		direction: direction,
+	})
+
+	return b
+}
+
+func (b *SelectBuilder) OrderByColumn(col nsql.ColumnWriter, args ...interface{}) *SelectBuilder {
+	// Evaluate options
+	opts := option.EvaluateOptions(args)
+
+	// Get direction
+	direction := opts.GetSortDirection()
+
+	b.orderBys = append(b.orderBys, &orderByWriter{
+		ColumnWriter: col,
+		direction:    direction,
+	})
+
+	return b
+}
+
+// OrderByCol add ORDER BY of typed column reference, column will always be resolved to the referenced schema
+func (b *SelectBuilder) OrderByCol(ref schema.ColumnRef, args ...interface{}) *SelectBuilder {
+	return b.OrderByColumn(b.qb.Col(ref), args...)
+}
+
+func (b *SelectBuilder) ResetOrderBy() *SelectBuilder {
+	b.orderBys = []nsql.OrderByWriter{}
+	return b
+}
+
+func (b *SelectBuilder) Limit(n int64) *SelectBuilder {
+	b.limit = &n
+	return b
+}
+
+func (b *SelectBuilder) ResetLimit() *SelectBuilder {
+	b.limit = nil
+	return b
+}
+
+func (b *SelectBuilder) Skip(n int64) *SelectBuilder {
+	b.skip = &n
+	return b
+}
+
+func (b *SelectBuilder) ResetSkip() *SelectBuilder {
+	b.skip = nil
+	return b
+}
+
+// Build write query. Set option.VariableFormat with op.DollarVar to write bind variables as $n placeholders
+func (b *SelectBuilder) Build(args ...interface{}) string {
+	q, _, _ := b.build(false, namespaceOption(args))
+	return b.qb.formatBindVars(q, args)
+}
+
+// BuildWithArgs build query and returns values that are bound to conditions in order of placeholders. Values are set
+// in conditions with Value or Values option setter. If a bind variable has no value, then panic
+func (b *SelectBuilder) BuildWithArgs(args ...interface{}) (string, []interface{}) {
+	q, values, _ := b.build(true, namespaceOption(args))
+	return b.qb.formatBindVars(q, args), values
+}
+
+// BuildE build query and returns nsql.BuildError instead of panic on invalid query declaration. In strict mode that is
+// enabled by option.Strict or nsql.SetStrictMode, it will return nsql.DroppedError instead of dropping query parts
+// silently
+func (b *SelectBuilder) BuildE(args ...interface{}) (q string, err error) {
+	defer nsql.RecoverBuildError(&err)
+
+	opts := option.EvaluateOptions(args)
+	q, _, dropped := b.build(false, namespaceOption(args))
+	if err := dropped.err(opts); err != nil {
+		return "", err
+	}
+	return b.qb.formatBindVars(q, args), nil
+}
+
+// Private methods
+
+// build write query. If withArgs is true, values that are bound to variables will be collected in order of placeholders.
+// If namespace is set, then it will override namespace of tables in FROM and JOIN
+func (b *SelectBuilder) build(withArgs bool, namespace string) (string, []interface{}, *droppedParts) {
+	// If query has invalid declaration, then panic
+	if b.err != nil {
+		panic(b.err)
+	}
+
+	// If FROM is not declared, then panic
+	if b.from == nil {
+		panic(nsql.NewBuildError(nsql.ErrUnknownTable, "FROM table is not declared in query"))
+	}
+
+	// Init dropped parts with parts that are dropped on declaring query
+	dropped := &droppedParts{parts: append([]string{}, b.dropped.parts...)}
+
+	// Resolve writers to tables in build state, so writers are not changed and builder can be built concurrently
+	st := &buildState{
+		from:   b.getFromSchema(),
+		tables: b.schemaRef,
+		joined: len(b.schemaRef) > 1,
+	}
+
+	// Resolve writers before writing query, since pagination clause depends on ORDER BY
+	fields := b.resolveSelectWriters(st, dropped)
+	where := b.resolveWhereWriter(st, dropped)
+	orderBys := b.resolveOrderByWriters(st, dropped)
+
+	// Write query parts into a single builder
+	var sb strings.Builder
+	sb.WriteString("SELECT ")
+	b.writePaginationPrefix(&sb)
+	args := b.writeSelectQuery(&sb, fields, st, withArgs)
+
+	// Write from query and collect args from JOIN
+	sb.WriteString(" FROM ")
+	b.writeFromQuery(&sb, namespace)
+	if ag, ok := b.from.(nsql.ArgsGetter); ok && withArgs {
+		args = append(args, ag.GetArgs()...)
+	}
+
+	// Write where query
+	if where != nil {
+		sb.WriteString(" WHERE ")
+		writeWhereState(&sb, where, st)
+		if withArgs {
+			args = st.whereArgs(where, args)
+		}
+	}
+
+	// Write order by query
+	if len(orderBys) > 0 {
+		sb.WriteString(" ORDER BY ")
+		for i, w := range orderBys {
+			if i > 0 {
+				sb.WriteString(nsql.Separator)
+			}
+			if ow, ok := w.(*orderByWriter); ok {
+				ow.writeOrderByState(&sb, st)
+			} else {
+				w.WriteOrderByQuery(&sb)
+			}
+		}
+	}
+
+	// Add limit and skip
+	b.writePaginationSuffix(&sb, len(orderBys) > 0)
+
+	return sb.String(), args, dropped
+}
+
+// writeFromQuery write FROM clause with namespace override
+func (b *SelectBuilder) writeFromQuery(sb *strings.Builder, namespace string) {
+	if tw, ok := b.from.(*tableWriter); ok {
+		tw.writeQuery(sb, namespace)
+		return
+	}
+	b.from.WriteFromQuery(sb)
+}
+
+// writePaginationPrefix write clause after SELECT keyword to limit selected rows, if it is written by dialect
+func (b *SelectBuilder) writePaginationPrefix(sb *strings.Builder) {
+	if p, ok := b.qb.dialect.(nsql.Paginator); ok {
+		p.WritePaginationPrefix(sb, b.limit, b.skip)
+	}
+}
+
+// writePaginationSuffix write clause that is appended to query to limit selected rows
+func (b *SelectBuilder) writePaginationSuffix(sb *strings.Builder, ordered bool) {
+	p, ok := b.qb.dialect.(nsql.Paginator)
+	if !ok {
+		b.qb.dialect.WriteLimitQuery(sb, b.limit, b.skip)
+		return
+	}
+
+	if err := p.WritePaginationSuffix(sb, b.limit, b.skip, ordered); err != nil {
+		panic(err)
+	}
+}
+
+// writeSelectQuery write selected columns and returns values that are bound in SELECT if withArgs is true
+func (b *SelectBuilder) writeSelectQuery(sb *strings.Builder, writers []nsql.SelectWriter, st *buildState, withArgs bool) []interface{} {
+	var args []interface{}
+	for i, w := range writers {
+		if i > 0 {
+			sb.WriteString(nsql.Separator)
+		}
+		if sw, ok := w.(selectStateWriter); ok {
+			sw.writeSelectState(sb, st)
+		} else {
+			w.WriteSelectQuery(sb)
+		}
+		if ag, ok := w.(nsql.ArgsGetter); ok && withArgs {
+			args = append(args, ag.GetArgs()...)
+		}
+	}
+	return args
+}
+
+// resolveSelectWriters resolve selected columns to tables and returns writers of columns that are written in query
+func (b *SelectBuilder) resolveSelectWriters(st *buildState, dropped *droppedParts) []nsql.SelectWriter {
+	writers := make([]nsql.SelectWriter, 0, len(b.fields))
+	for _, f := range b.fields {
+		// If force flag is set, then add to writers list
+		if f.GetTableName() == forceWriteFlag {
+			writers = append(writers, f)
+			continue
+		}
+
+		// Get existing table, if not then filter out writer
+		table := st.table(f)
+		if table == nil {
+			dropped.add("SELECT", f)
+			continue
+		}
+
+		// Collect columns that are not declared in schema
+		if cw, cOk := f.(*ColumnSchemaWriter); cOk {
+			for _, col := range cw.undeclaredColumns(cw.resolveSchema(st)) {
+				dropped.add("SELECT", &ColumnWriter{qb: b.qb, name: col, tableName: skipTableFlag})
+			}
+		}
+
+		// If writer that is not implemented by this package is set for all columns and expandable, then expand with
+		// given schema and replace writer
+		if exp, ok := f.(nsql.Expander); ok && f.IsAllColumns() {
+			if _, ok = f.(selectStateWriter); !ok {
+				f = exp.Expand(option.Schema(table))
+			}
+		}
+
+		// Push to select writer list
+		writers = append(writers, f)
+	}
+
+	return writers
+}
+
+// resolveOrderByWriters resolve order by columns to tables and returns writers that are written in query
+func (b *SelectBuilder) resolveOrderByWriters(st *buildState, dropped *droppedParts) []nsql.OrderByWriter {
+	// If empty, then return empty writers
+	if len(b.orderBys) == 0 {
+		return nil
+	}
+
+	// Prepare order by writers
+	writers := make([]nsql.OrderByWriter, 0, len(b.orderBys))
+	for _, f := range b.orderBys {
+		// Get existing table, if not then filter out writer
+		if st.table(f) == nil {
+			dropped.add("ORDER BY", f)
+			continue
+		}
+
+		writers = append(writers, f)
+	}
+
+	return writers
+}
+
+// resolveWhereWriter collect conditions that are dropped and returns nil if conditions write empty query
+func (b *SelectBuilder) resolveWhereWriter(st *buildState, dropped *droppedParts) nsql.WhereWriter {
+	if b.where == nil {
+		return nil
+	}
+
+	collectDroppedConditions(b.where, st, dropped)
+	if st.isEmptyWhere(b.where) {
+		return nil
+	}
+
+	return b.where
+}
+
+// getFromSchema retrieve schema that is defined in FROM
+func (b *SelectBuilder) getFromSchema() *schema.Schema {
+	sRef := b.from.GetSchemaRef()
+	return b.schemaRef[sRef]
+}
+
+func (b *SelectBuilder) setJoinTableAs(onCondition nsql.WhereWriter, joinTable *schema.Schema) (err error) {
+	defer nsql.RecoverBuildError(&err)
+	setJoinTableAs(onCondition, joinTable, b.schemaRef)
+	return nil
+}
+
+func (b *SelectBuilder) addTable(s *schema.Schema) {
+	b.schemaRef[s.Ref()] = s
+}
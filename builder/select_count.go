@@ -0,0 +1,99 @@
+package builder
+
+import (
+	"github.com/nbs-go/nsql"
+	"github.com/nbs-go/nsql/op"
+	"github.com/nbs-go/nsql/option"
+	"strings"
+)
+
+func (q *Builder) Count(column string, args ...interface{}) *SelectCountWriter {
+	// Get options
+	opts := option.EvaluateOptions(args)
+	s := opts.GetSchema()
+	as, _ := opts.GetString(option.AsKey)
+
+	// If all column, then create
+	var allColumn bool
+	var cw nsql.ColumnWriter
+	if column == AllColumns {
+		cw = &ColumnWriter{
+			qb:        q,
+			name:      column,
+			tableName: forceWriteFlag,
+		}
+		allColumn = true
+	} else if s != nil {
+		// If column is invalid or not in schema, then skip
+		if !s.IsColumnExist(column) {
+			panic(nsql.NewBuildError(nsql.ErrUnknownColumn, `column "%s" is not declared in schema "%s"`, column, s.TableName()))
+		}
+
+		// Set writer
+		cw = &ColumnWriter{
+			qb:        q,
+			name:      column,
+			tableName: s.TableName(),
+			tableAs:   s.As(),
+		}
+	} else {
+		// Set writer with FROM flag
+		cw = &ColumnWriter{
+			qb:        q,
+			name:      column,
+			tableName: fromTableFlag,
+		}
+	}
+
+	return &SelectCountWriter{qb: q, ColumnWriter: cw, as: as, allColumn: allColumn}
+}
+
+// SelectCountWriter implements query.SelectWriter that wrap column with COUNT() function
+type SelectCountWriter struct {
+	nsql.ColumnWriter
+	qb        *Builder
+	as        string
+	allColumn bool
+}
+
+func (s *SelectCountWriter) IsAllColumns() bool {
+	return s.allColumn
+}
+
+func (s *SelectCountWriter) ColumnQuery() string {
+	return writeQuery(s.WriteColumnQuery)
+}
+
+func (s *SelectCountWriter) WriteColumnQuery(sb *strings.Builder) {
+	s.writeColumnState(sb, nil)
+}
+
+func (s *SelectCountWriter) writeColumnState(sb *strings.Builder, st *buildState) {
+	if s.allColumn {
+		sb.WriteString("COUNT(*)")
+		return
+	}
+	// Print column
+	sb.WriteString("COUNT(")
+	writeColumnState(sb, s.ColumnWriter, st)
+	sb.WriteByte(')')
+}
+
+func (s *SelectCountWriter) SelectQuery() string {
+	return writeQuery(s.WriteSelectQuery)
+}
+
+func (s *SelectCountWriter) WriteSelectQuery(sb *strings.Builder) {
+	s.writeSelectState(sb, nil)
+}
+
+func (s *SelectCountWriter) writeSelectState(sb *strings.Builder, st *buildState) {
+	s.writeColumnState(sb, st)
+
+	// Set "as" query
+	if s.as != "" {
+		s.qb.writeAs(sb, s.as)
+	}
+}
+
+func (s *SelectCountWriter) SetFormat(_ op.ColumnFormat) {}
@@ -0,0 +1,48 @@
+package builder
+
+import (
+	"fmt"
+	"github.com/nbs-go/nsql"
+	"github.com/nbs-go/nsql/option"
+)
+
+// droppedParts collect parts of query that are dropped by builder
+type droppedParts struct {
+	parts []string
+}
+
+// clone returns a copy of droppedParts
+func (d droppedParts) clone() droppedParts {
+	return droppedParts{parts: append([]string(nil), d.parts...)}
+}
+
+// add describe dropped writer in a clause
+func (d *droppedParts) add(clause string, w interface{}) {
+	// Get column
+	col := "?"
+	if cg, ok := w.(nsql.ColumnGetter); ok {
+		col = cg.GetColumn()
+	}
+
+	// If writer is marked as skipped or is not resolved to FROM table, then column is not declared in schema
+	if tg, ok := w.(nsql.TableGetter); ok && (tg.GetTableName() == skipTableFlag || tg.GetTableName() == fromTableFlag) {
+		d.parts = append(d.parts, fmt.Sprintf(`%s column "%s" is not declared in schema`, clause, col))
+		return
+	}
+
+	// Get table reference
+	var ref string
+	if rg, ok := w.(nsql.SchemaRefGetter); ok {
+		ref = string(rg.GetSchemaRef())
+	}
+	d.parts = append(d.parts, fmt.Sprintf(`%s column "%s" refers to table "%s" that is not declared in query`,
+		clause, col, ref))
+}
+
+// err returns nsql.DroppedError if strict mode is enabled in options and there are dropped parts
+func (d *droppedParts) err(opts *option.Options) error {
+	if len(d.parts) == 0 || !opts.GetStrict() {
+		return nil
+	}
+	return &nsql.DroppedError{Parts: d.parts}
+}
@@ -0,0 +1,206 @@
+package builder
+
+import (
+	"fmt"
+	"github.com/nbs-go/nsql"
+	"github.com/nbs-go/nsql/op"
+	"github.com/nbs-go/nsql/option"
+	"github.com/nbs-go/nsql/schema"
+	"strings"
+)
+
+type UpdateBuilder struct {
+	qb      *Builder
+	schema  *schema.Schema
+	columns []string
+	where   nsql.WhereWriter
+	dropped droppedParts
+	values  valueMap
+}
+
+func (b *UpdateBuilder) Build(args ...interface{}) string {
+	// Get variable format option
+	opts := option.EvaluateOptions(args)
+	format, ok := opts.GetVariableFormat()
+	if !ok {
+		// If var format is not defined, then set default to query.NamedVar
+		format = b.qb.defaultFormat(op.NamedVar)
+	}
+
+	return b.build(format, namespaceOption(args))
+}
+
+// Values set values of updated columns in order of columns that are declared in Update. If all columns are updated,
+// then values must be in order of schema.Schema UpdateColumns
+func (b *UpdateBuilder) Values(v ...interface{}) *UpdateBuilder {
+	b.values.values = v
+	return b
+}
+
+// BuildWithArgs build query with bind variables and returns values in order of placeholders. Values of updated columns
+// are set by Values and followed by values that are bound to WHERE conditions. If Where is not set, then value of
+// primary key condition must be set by Value option
+func (b *UpdateBuilder) BuildWithArgs(args ...interface{}) (string, []interface{}) {
+	q := b.build(b.qb.bindVarFormat(args), namespaceOption(args))
+
+	// Collect args
+	values := b.values.args("UPDATE")
+	if b.where == nil {
+		values = append(values, primaryKeyArgs("UPDATE", b.schema, args)...)
+	} else if ag, ok := b.where.(nsql.ArgsGetter); ok {
+		values = append(values, ag.GetArgs()...)
+	}
+
+	return q, values
+}
+
+func (b *UpdateBuilder) build(format op.VariableFormat, namespace string) string {
+	// If no column is defined, then panic
+	count := len(b.columns)
+	if count == 0 {
+		panic(nsql.NewBuildError(nsql.ErrNoColumns, `no column defined on update table "%s"`, b.schema.TableName()))
+	}
+
+	// Write positional placeholders by rebinding query that is written with bind variables
+	if isPositional(format) {
+		b.qb.checkPositional(format)
+		return nsql.Rebind(format, b.build(op.BindVar, namespace))
+	}
+
+	// Check conditions are declared in updated table, variable format is written with build state
+	where := b.whereWriter()
+	checkUpdateConditions(where, b.schema)
+	st := &buildState{update: b.schema, varFormat: format}
+
+	var sb strings.Builder
+	sb.WriteString("UPDATE ")
+	b.qb.writeTable(&sb, namespace, b.schema.Namespace(), b.schema.TableName())
+
+	// Write assignments queries
+	// TODO: Refactor as AssignmentsWriter query
+	sb.WriteString(" SET ")
+	for i, v := range b.columns {
+		if i > 0 {
+			sb.WriteString(nsql.Separator)
+		}
+		sb.WriteString(b.qb.dialect.QuoteIdent(v))
+		switch format {
+		case op.BindVar:
+			sb.WriteString(" = ?")
+		case op.NamedVar:
+			sb.WriteString(" = :")
+			sb.WriteString(v)
+		}
+	}
+
+	// Write where
+	sb.WriteString(" WHERE ")
+	writeWhereState(&sb, where, st)
+
+	return sb.String()
+}
+
+// BuildE build query and returns nsql.BuildError instead of panic on invalid query declaration. In strict mode that is
+// enabled by option.Strict or nsql.SetStrictMode, it will return nsql.DroppedError instead of dropping undeclared
+// columns silently
+func (b *UpdateBuilder) BuildE(args ...interface{}) (q string, err error) {
+	defer nsql.RecoverBuildError(&err)
+
+	opts := option.EvaluateOptions(args)
+	if err := b.dropped.err(opts); err != nil {
+		return "", err
+	}
+	return b.Build(args...), nil
+}
+
+func (b *UpdateBuilder) Where(w nsql.WhereWriter) *UpdateBuilder {
+	b.where = w
+	return b
+}
+
+func (q *Builder) Update(s *schema.Schema, column string, columnN ...string) *UpdateBuilder {
+	// Init builder
+	b := UpdateBuilder{
+		qb:     q,
+		schema: s,
+	}
+
+	var columns []string
+	if column == AllColumns {
+		// Get all columns
+		columns = s.UpdateColumns()
+		b.values.declare(len(columns))
+		for i := range columns {
+			b.values.use(i)
+		}
+	} else {
+		inColumns := append([]string{column}, columnN...)
+		b.values.declare(len(inColumns))
+		pk := s.PrimaryKey()
+		for i, c := range inColumns {
+			if !s.IsColumnExist(c) {
+				b.dropped.parts = append(b.dropped.parts,
+					fmt.Sprintf(`UPDATE column "%s" is not declared in schema "%s"`, c, s.TableName()))
+				continue
+			}
+
+			if c != pk {
+				columns = append(columns, c)
+				b.values.use(i)
+			}
+		}
+	}
+
+	// Set columns
+	b.columns = columns
+
+	return &b
+}
+
+// UpdateCol create UpdateBuilder from typed column references
+func (q *Builder) UpdateCol(ref1 schema.ColumnRef, refN ...schema.ColumnRef) *UpdateBuilder {
+	s, columns := resolveColumnRefs(ref1, refN)
+	return q.Update(s, columns[0], columns[1:]...)
+}
+
+// primaryKeyArgs returns value of default condition on primary key that is set by Value option. If value is not set,
+// then panic
+func primaryKeyArgs(clause string, s *schema.Schema, args []interface{}) []interface{} {
+	values := option.EvaluateOptions(args).GetValues()
+	if len(values) != 1 {
+		panic(nsql.NewBuildError(nsql.ErrNoArguments,
+			`%s condition on primary key "%s" expects 1 value set by Value option, got %d`, clause, s.PrimaryKey(),
+			len(values)))
+	}
+	return values
+}
+
+// checkUpdateConditions check columns of conditions are declared in schema
+func checkUpdateConditions(ww nsql.WhereWriter, s *schema.Schema) {
+	switch w := ww.(type) {
+	case nsql.WhereLogicWriter:
+		// Get conditions
+		for _, cw := range w.GetConditions() {
+			checkUpdateConditions(cw, s)
+		}
+	case nsql.WhereCompareWriter:
+		// Get column
+		cw, ok := w.(nsql.ColumnWriter)
+		if !ok {
+			panic(nsql.NewBuildError(nsql.ErrInvalidArgument, "update condition did not implement query.ColumnWriter"))
+		}
+
+		// Check if column is not part if schema
+		if !s.IsColumnExist(cw.GetColumn()) {
+			panic(nsql.NewBuildError(nsql.ErrUnknownColumn, `invalid column "%s" is not defined in Schema "%s"`, cw.GetColumn(), s.TableName()))
+		}
+	}
+}
+
+// whereWriter returns where conditions, or condition on primary key if where is not set
+func (b *UpdateBuilder) whereWriter() nsql.WhereWriter {
+	if b.where == nil {
+		return b.qb.Equal(b.qb.Column(b.schema.PrimaryKey(), b.schema))
+	}
+	return b.where
+}
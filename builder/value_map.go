@@ -0,0 +1,41 @@
+package builder
+
+import "github.com/nbs-go/nsql"
+
+// valueMap map values that are set in order of declared columns to columns that are written in query
+type valueMap struct {
+	declared int
+	indexes  []int
+	values   []interface{}
+}
+
+// declare set count of declared columns
+func (m *valueMap) declare(n int) {
+	m.declared = n
+}
+
+// use mark declared column at index i as written column
+func (m *valueMap) use(i int) {
+	m.indexes = append(m.indexes, i)
+}
+
+// clone returns a copy of valueMap
+func (m valueMap) clone() valueMap {
+	m.indexes = append([]int(nil), m.indexes...)
+	m.values = append([]interface{}(nil), m.values...)
+	return m
+}
+
+// args returns values of written columns. If values count does not match declared columns, then panic
+func (m *valueMap) args(clause string) []interface{} {
+	if len(m.values) != m.declared {
+		panic(nsql.NewBuildError(nsql.ErrInvalidArgument, "%s expects %d value(s), got %d", clause, m.declared,
+			len(m.values)))
+	}
+
+	args := make([]interface{}, len(m.indexes))
+	for i, idx := range m.indexes {
+		args[i] = m.values[idx]
+	}
+	return args
+}
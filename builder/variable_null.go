@@ -0,0 +1,13 @@
+package builder
+
+import "strings"
+
+type nullVar struct{}
+
+func (b *nullVar) VariableQuery() string {
+	return writeQuery(b.WriteVariableQuery)
+}
+
+func (b *nullVar) WriteVariableQuery(sb *strings.Builder) {
+	sb.WriteString("NULL")
+}
@@ -0,0 +1,352 @@
+package builder
+
+import (
+	"github.com/nbs-go/nsql"
+	"github.com/nbs-go/nsql/op"
+	"github.com/nbs-go/nsql/option"
+	"github.com/nbs-go/nsql/schema"
+)
+
+// WhereCompareWriter
+
+func (q *Builder) newWhereComparisonWriter(col nsql.ColumnWriter, operator op.Operator, args []interface{}) *WhereCompareWriter {
+	opts := option.EvaluateOptions(args)
+
+	// Get variable writer
+	v := opts.GetVariable(option.VariableKey)
+	if v == nil {
+		// Set default variable writer, by operator
+		switch operator {
+		case op.Equal, op.NotEqual, op.GreaterThan, op.GreaterThanEqual, op.LessThan, op.LessThanEqual, op.Like,
+			op.NotLike, op.ILike, op.NotILike:
+			v = new(bindVar)
+		case op.Between, op.NotBetween:
+			v = new(betweenBindVar)
+		case op.Is, op.IsNot:
+			v = new(nullVar)
+		}
+	}
+
+	// Get alias
+	as, _ := opts.GetString(option.AsKey)
+
+	return &WhereCompareWriter{
+		qb:           q,
+		ColumnWriter: col,
+		op:           operator,
+		variable:     v,
+		as:           as,
+		args:         evaluateValues(col, v, opts),
+	}
+}
+
+func (q *Builder) newInWhereComparisonWriter(col nsql.ColumnWriter, argCount int, operator op.Operator, args []interface{}) *WhereCompareWriter {
+	opts := option.EvaluateOptions(args)
+
+	// Get variable writer
+	v := opts.GetVariable(option.VariableKey)
+	if v == nil {
+		// Set default variable writer, by operator
+		switch operator {
+		case op.In, op.NotIn:
+			v = &inBindVar{argCount: argCount}
+		}
+	}
+
+	// Get alias
+	as, _ := opts.GetString(option.AsKey)
+
+	return &WhereCompareWriter{
+		qb:           q,
+		ColumnWriter: col,
+		op:           operator,
+		variable:     v,
+		as:           as,
+		args:         evaluateValues(col, v, opts),
+	}
+}
+
+func (q *Builder) Equal(col nsql.ColumnWriter, args ...interface{}) *WhereCompareWriter {
+	return q.newWhereComparisonWriter(col, op.Equal, args)
+}
+
+func (q *Builder) NotEqual(col nsql.ColumnWriter, args ...interface{}) *WhereCompareWriter {
+	return q.newWhereComparisonWriter(col, op.NotEqual, args)
+}
+
+func (q *Builder) GreaterThan(col nsql.ColumnWriter, args ...interface{}) *WhereCompareWriter {
+	return q.newWhereComparisonWriter(col, op.GreaterThan, args)
+}
+
+func (q *Builder) GreaterThanEqual(col nsql.ColumnWriter, args ...interface{}) *WhereCompareWriter {
+	return q.newWhereComparisonWriter(col, op.GreaterThanEqual, args)
+}
+
+func (q *Builder) LessThan(col nsql.ColumnWriter, args ...interface{}) *WhereCompareWriter {
+	return q.newWhereComparisonWriter(col, op.LessThan, args)
+}
+
+func (q *Builder) LessThanEqual(col nsql.ColumnWriter, args ...interface{}) *WhereCompareWriter {
+	return q.newWhereComparisonWriter(col, op.LessThanEqual, args)
+}
+
+func (q *Builder) Like(col nsql.ColumnWriter, args ...interface{}) *WhereCompareWriter {
+	return q.newWhereComparisonWriter(col, op.Like, args)
+}
+
+func (q *Builder) NotLike(col nsql.ColumnWriter, args ...interface{}) *WhereCompareWriter {
+	return q.newWhereComparisonWriter(col, op.NotLike, args)
+}
+
+func (q *Builder) ILike(col nsql.ColumnWriter, args ...interface{}) *WhereCompareWriter {
+	return q.newWhereComparisonWriter(col, op.ILike, args)
+}
+
+func (q *Builder) NotILike(col nsql.ColumnWriter, args ...interface{}) *WhereCompareWriter {
+	return q.newWhereComparisonWriter(col, op.NotILike, args)
+}
+
+func (q *Builder) Between(col nsql.ColumnWriter, args ...interface{}) *WhereCompareWriter {
+	return q.newWhereComparisonWriter(col, op.Between, args)
+}
+
+func (q *Builder) NotBetween(col nsql.ColumnWriter, args ...interface{}) *WhereCompareWriter {
+	return q.newWhereComparisonWriter(col, op.NotBetween, args)
+}
+
+func (q *Builder) In(col nsql.ColumnWriter, argCount int, args ...interface{}) *WhereCompareWriter {
+	return q.newInWhereComparisonWriter(col, argCount, op.In, args)
+}
+
+func (q *Builder) NotIn(col nsql.ColumnWriter, argCount int, args ...interface{}) *WhereCompareWriter {
+	return q.newInWhereComparisonWriter(col, argCount, op.NotIn, args)
+}
+
+// InValues create IN condition that has bind variables for each item in values. Values must be a slice. If values is
+// empty, then condition will be written as an always false predicate. Use AnyArray option to write condition as
+// "= ANY(?)" that is bound as an array, if it is supported by dialect
+func (q *Builder) InValues(col nsql.ColumnWriter, values interface{}, args ...interface{}) *WhereCompareWriter {
+	return q.newInValuesWriter(col, op.In, values, args)
+}
+
+// NotInValues create NOT IN condition that has bind variables for each item in values. Values must be a slice. If
+// values is empty, then condition will be written as an always true predicate. Use AnyArray option to write condition
+// as "!= ALL(?)" that is bound as an array, if it is supported by dialect
+func (q *Builder) NotInValues(col nsql.ColumnWriter, values interface{}, args ...interface{}) *WhereCompareWriter {
+	return q.newInValuesWriter(col, op.NotIn, values, args)
+}
+
+func (q *Builder) newInValuesWriter(col nsql.ColumnWriter, operator op.Operator, values interface{}, args []interface{}) *WhereCompareWriter {
+	opts := option.EvaluateOptions(args)
+
+	// Get alias
+	as, _ := opts.GetString(option.AsKey)
+
+	w := WhereCompareWriter{
+		qb:           q,
+		ColumnWriter: col,
+		op:           operator,
+		as:           as,
+	}
+
+	// If any array is set, then bind values as array
+	if anyArray, _ := opts.KV[anyArrayKey].(bool); anyArray {
+		ab, ok := q.dialect.(nsql.ArrayBinder)
+		if !ok {
+			panic(nsql.NewBuildError(nsql.ErrInvalidArgument, "AnyArray option is not supported by %s", q.dialect.Name()))
+		}
+
+		if operator == op.In {
+			w.op = op.Equal
+			w.variable = &arrayVar{fn: "ANY"}
+		} else {
+			w.op = op.NotEqual
+			w.variable = &arrayVar{fn: "ALL"}
+		}
+		w.args = []interface{}{ab.BindArray(values)}
+		return &w
+	}
+
+	// Expand values
+	items := sliceValues(values)
+	if len(items) == 0 {
+		w.variable = new(emptyListVar)
+		w.args = []interface{}{}
+		return &w
+	}
+
+	w.variable = &inBindVar{argCount: len(items)}
+	w.args = items
+	return &w
+}
+
+func (q *Builder) IsNull(col nsql.ColumnWriter, args ...interface{}) *WhereCompareWriter {
+	return q.newWhereComparisonWriter(col, op.Is, args)
+}
+
+func (q *Builder) IsNotNull(col nsql.ColumnWriter, args ...interface{}) *WhereCompareWriter {
+	return q.newWhereComparisonWriter(col, op.IsNot, args)
+}
+
+// evaluateValues returns values that are bound to variable. If values count does not match with placeholders, then
+// panic
+func evaluateValues(col nsql.ColumnWriter, v nsql.VariableWriter, opts *option.Options) []interface{} {
+	values := opts.GetValues()
+	if values == nil {
+		return nil
+	}
+
+	if n := countBindVars(v); len(values) != n {
+		panic(nsql.NewBuildError(nsql.ErrInvalidArgument, `variable of column "%s" expects %d value(s), got %d`,
+			col.GetColumn(), n, len(values)))
+	}
+
+	return values
+}
+
+// WhereLogicWriter
+
+func newWhereLogicalWriter(operator op.Operator, cn []nsql.WhereWriter) *WhereLogicWriter {
+	return &WhereLogicWriter{
+		op:         operator,
+		conditions: cn,
+	}
+}
+
+func And(cn ...nsql.WhereWriter) *WhereLogicWriter {
+	return newWhereLogicalWriter(op.And, cn)
+}
+
+func Or(cn ...nsql.WhereWriter) *WhereLogicWriter {
+	return newWhereLogicalWriter(op.Or, cn)
+}
+
+func resolveFromTableFlag(ww nsql.WhereWriter, from *schema.Schema) {
+	// Switch type
+	switch w := ww.(type) {
+	case nsql.WhereLogicWriter:
+		// Get conditions
+		for _, cw := range w.GetConditions() {
+			resolveFromTableFlag(cw, from)
+		}
+	case nsql.WhereCompareWriter:
+		// Get alias
+		if w.GetTableName() == fromTableFlag {
+			w.SetSchema(from)
+		}
+	}
+}
+
+// collectDroppedConditions add conditions that are not resolved to tables in build state to dropped parts
+func collectDroppedConditions(ww nsql.WhereWriter, st *buildState, dropped *droppedParts) {
+	switch w := ww.(type) {
+	case nsql.WhereLogicWriter:
+		for _, cw := range w.GetConditions() {
+			collectDroppedConditions(cw, st, dropped)
+		}
+	case nsql.WhereCompareWriter:
+		// Check if condition is registered in table
+		if st.table(w) == nil {
+			dropped.add("WHERE", w)
+		}
+	}
+}
+
+// collectSkippedConditions add conditions and variable columns that are marked as skipped to dropped parts
+func collectSkippedConditions(ww nsql.WhereWriter, clause string, dropped *droppedParts) {
+	switch w := ww.(type) {
+	case nsql.WhereLogicWriter:
+		for _, cw := range w.GetConditions() {
+			collectSkippedConditions(cw, clause, dropped)
+		}
+	case nsql.WhereCompareWriter:
+		if w.GetTableName() == skipTableFlag {
+			dropped.add(clause, w)
+			return
+		}
+
+		// Check variable column
+		if cv, ok := w.GetVariable().(nsql.ColumnWriter); ok && cv.GetTableName() == skipTableFlag {
+			dropped.add(clause, cv)
+		}
+	}
+}
+
+func resolveJoinTableFlag(ww nsql.WhereWriter, joinTable *schema.Schema) {
+	// Switch type
+	switch w := ww.(type) {
+	case nsql.WhereLogicWriter:
+		// Get conditions
+		for _, cw := range w.GetConditions() {
+			resolveJoinTableFlag(cw, joinTable)
+		}
+	case nsql.WhereCompareWriter:
+		// Get variable
+		v := w.GetVariable()
+
+		// Cast as column
+		cv, ok := v.(nsql.ColumnWriter)
+		if !ok {
+			return
+		}
+
+		// If join table flag reference is set, then set schema
+		if cv.GetTableName() == joinTableFlag {
+			cv.SetSchema(joinTable)
+		}
+	}
+}
+
+func setJoinTableAs(ww nsql.WhereWriter, joinTable *schema.Schema, tableRefs map[schema.Reference]*schema.Schema) {
+	switch w := ww.(type) {
+	case nsql.WhereLogicWriter:
+		// Get conditions
+		for _, cw := range w.GetConditions() {
+			setJoinTableAs(cw, joinTable, tableRefs)
+		}
+	case nsql.WhereCompareWriter:
+		// Get column
+		if cw, ok := w.(nsql.ColumnWriter); ok {
+			setJoinColumnTableAs(cw, joinTable, tableRefs)
+		}
+
+		// Get variable and set table alias
+		v := w.GetVariable()
+		if cv, ok := v.(nsql.ColumnWriter); ok {
+			setJoinColumnTableAs(cv, joinTable, tableRefs)
+		}
+	}
+}
+
+func setJoinColumnTableAs(column nsql.ColumnWriter, joinTable *schema.Schema, tableRefs map[schema.Reference]*schema.Schema) {
+	// Get arguments
+	tableName := column.GetTableName()
+
+	if tableName == skipTableFlag {
+		return
+	}
+
+	col := column.GetColumn()
+
+	// Check in joinSchema
+	if tableName == joinTable.TableName() {
+		if !joinTable.IsColumnExist(col) {
+			panic(nsql.NewBuildError(nsql.ErrUnknownColumn, `column "%s" is not declared in Table "%s"`, col, joinTable.TableName()))
+		}
+		// Set alias
+		column.SetTableAs(joinTable.As())
+		return
+	}
+
+	// Check in tableRefs
+	sRef := column.GetSchemaRef()
+	tRef, tRefOk := tableRefs[sRef]
+	if !tRefOk {
+		panic(nsql.NewBuildError(nsql.ErrUnknownTable, `table "%s" is not declared in Query Builder`, tableName))
+	}
+	// Check against column
+	if !tRef.IsColumnExist(col) {
+		panic(nsql.NewBuildError(nsql.ErrUnknownColumn, `column "%s" is not declared in Table "%s"`, tableName, col))
+	}
+	column.SetTableAs(tRef.As())
+}
@@ -0,0 +1,53 @@
+package builder
+
+import (
+	"github.com/nbs-go/nsql/op"
+	"github.com/nbs-go/nsql/schema"
+)
+
+// EqualCol create "=" condition of typed column reference. Value must be of column type T, so mismatched value type
+// is caught as compile error
+func EqualCol[T any](q *Builder, c schema.Col[T], v T, args ...interface{}) *WhereCompareWriter {
+	return q.newColComparisonWriter(c, op.Equal, v, args)
+}
+
+// NotEqualCol create "!=" condition of typed column reference with value of column type T
+func NotEqualCol[T any](q *Builder, c schema.Col[T], v T, args ...interface{}) *WhereCompareWriter {
+	return q.newColComparisonWriter(c, op.NotEqual, v, args)
+}
+
+// GreaterThanCol create ">" condition of typed column reference with value of column type T
+func GreaterThanCol[T any](q *Builder, c schema.Col[T], v T, args ...interface{}) *WhereCompareWriter {
+	return q.newColComparisonWriter(c, op.GreaterThan, v, args)
+}
+
+// GreaterThanEqualCol create ">=" condition of typed column reference with value of column type T
+func GreaterThanEqualCol[T any](q *Builder, c schema.Col[T], v T, args ...interface{}) *WhereCompareWriter {
+	return q.newColComparisonWriter(c, op.GreaterThanEqual, v, args)
+}
+
+// LessThanCol create "<" condition of typed column reference with value of column type T
+func LessThanCol[T any](q *Builder, c schema.Col[T], v T, args ...interface{}) *WhereCompareWriter {
+	return q.newColComparisonWriter(c, op.LessThan, v, args)
+}
+
+// LessThanEqualCol create "<=" condition of typed column reference with value of column type T
+func LessThanEqualCol[T any](q *Builder, c schema.Col[T], v T, args ...interface{}) *WhereCompareWriter {
+	return q.newColComparisonWriter(c, op.LessThanEqual, v, args)
+}
+
+// InCol create IN condition of typed column reference with values of column type T
+func InCol[T any](q *Builder, c schema.Col[T], values []T, args ...interface{}) *WhereCompareWriter {
+	return q.InValues(q.Col(c), values, args...)
+}
+
+// NotInCol create NOT IN condition of typed column reference with values of column type T
+func NotInCol[T any](q *Builder, c schema.Col[T], values []T, args ...interface{}) *WhereCompareWriter {
+	return q.NotInValues(q.Col(c), values, args...)
+}
+
+// newColComparisonWriter create condition of column reference that is bound to value
+func (q *Builder) newColComparisonWriter(ref schema.ColumnRef, operator op.Operator, v interface{}, args []interface{}) *WhereCompareWriter {
+	args = append([]interface{}{Value(v)}, args...)
+	return q.newWhereComparisonWriter(q.Col(ref), operator, args)
+}
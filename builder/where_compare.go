@@ -0,0 +1,122 @@
+package builder
+
+import (
+	"github.com/nbs-go/nsql"
+	"github.com/nbs-go/nsql/op"
+	"strings"
+)
+
+type WhereCompareWriter struct {
+	nsql.ColumnWriter
+	qb       *Builder
+	op       op.Operator
+	variable nsql.VariableWriter
+	as       string
+	args     []interface{}
+}
+
+func (w *WhereCompareWriter) SelectQuery() string {
+	return writeQuery(w.WriteSelectQuery)
+}
+
+func (w *WhereCompareWriter) WriteSelectQuery(sb *strings.Builder) {
+	w.writeSelectState(sb, nil)
+}
+
+func (w *WhereCompareWriter) writeSelectState(sb *strings.Builder, st *buildState) {
+	// If dialect can not select predicate, then write predicate as value
+	if bw, ok := w.qb.dialect.(nsql.BooleanWriter); ok {
+		sb.WriteString(bw.SelectPredicate(writeQuery(func(psb *strings.Builder) {
+			w.writeWhereState(psb, st)
+		})))
+	} else {
+		w.writeWhereState(sb, st)
+	}
+
+	if w.as != "" {
+		w.qb.writeAs(sb, w.as)
+	}
+}
+
+func (w *WhereCompareWriter) IsAllColumns() bool {
+	return false
+}
+
+func (w *WhereCompareWriter) GetVariable() nsql.VariableWriter {
+	return w.variable
+}
+
+func (w *WhereCompareWriter) SetVariable(v nsql.VariableWriter) {
+	w.variable = v
+}
+
+// GetArgs returns values that are bound to variable. If variable has placeholders but no values are set, then panic
+func (w *WhereCompareWriter) GetArgs() []interface{} {
+	if w.GetTableName() == skipTableFlag {
+		return nil
+	}
+
+	if w.args == nil && countBindVars(w.variable) > 0 {
+		panic(nsql.NewBuildError(nsql.ErrNoArguments, `bind variable of column "%s" has no value`, w.GetColumn()))
+	}
+
+	return w.args
+}
+
+func (w *WhereCompareWriter) WhereQuery() string {
+	return writeQuery(w.WriteWhereQuery)
+}
+
+func (w *WhereCompareWriter) WriteWhereQuery(sb *strings.Builder) {
+	w.writeWhereState(sb, nil)
+}
+
+func (w *WhereCompareWriter) writeWhereState(sb *strings.Builder, st *buildState) {
+	if st.isEmptyWhere(w) {
+		return
+	}
+
+	// If IN condition has no values, then write constant predicate
+	if _, ok := w.variable.(*emptyListVar); ok {
+		if w.op == op.NotIn {
+			sb.WriteString("1 = 1")
+		} else {
+			sb.WriteString("1 = 0")
+		}
+		return
+	}
+
+	writeColumnState(sb, w.ColumnWriter, st)
+	sb.WriteByte(' ')
+	sb.WriteString(w.qb.dialect.Operator(w.op))
+	sb.WriteByte(' ')
+
+	// Write variable with format of UPDATE or DELETE query
+	if st != nil && st.update != nil {
+		w.writeUpdateVariable(sb, st.varFormat)
+		return
+	}
+
+	// Write boolean value with dialect literal
+	if bv, ok := w.variable.(*boolVar); ok {
+		if bw, bOk := w.qb.dialect.(nsql.BooleanWriter); bOk {
+			sb.WriteString(bw.BoolLiteral(bv.value))
+			return
+		}
+	}
+	w.variable.WriteVariableQuery(sb)
+}
+
+// writeUpdateVariable write variable of UPDATE or DELETE condition. Bind variables that write list or range, such as
+// IN and BETWEEN, are kept
+func (w *WhereCompareWriter) writeUpdateVariable(sb *strings.Builder, format op.VariableFormat) {
+	switch {
+	case format == op.NamedVar:
+		sb.WriteByte(':')
+		sb.WriteString(w.GetColumn())
+	case format == op.BindVar && !isListVar(w.variable):
+		sb.WriteByte('?')
+	default:
+		w.variable.WriteVariableQuery(sb)
+	}
+}
@@ -0,0 +1,72 @@
+package builder
+
+import (
+	"github.com/nbs-go/nsql"
+	"github.com/nbs-go/nsql/op"
+	"strings"
+)
+
+type WhereLogicWriter struct {
+	op         op.Operator
+	conditions []nsql.WhereWriter
+}
+
+func (w *WhereLogicWriter) SetConditions(conditions []nsql.WhereWriter) {
+	w.conditions = conditions
+}
+
+func (w *WhereLogicWriter) WhereQuery() string {
+	return writeQuery(w.WriteWhereQuery)
+}
+
+func (w *WhereLogicWriter) WriteWhereQuery(sb *strings.Builder) {
+	w.writeWhereState(sb, nil)
+}
+
+func (w *WhereLogicWriter) writeWhereState(sb *strings.Builder, st *buildState) {
+	var separator string
+	if w.op == op.Or {
+		separator = " OR "
+	} else {
+		separator = " AND "
+	}
+
+	first := true
+	for _, cw := range w.conditions {
+		// Skip condition that writes empty query
+		if st.isEmptyWhere(cw) {
+			continue
+		}
+
+		// Write separator
+		if !first {
+			sb.WriteString(separator)
+		}
+		first = false
+
+		// If condition is a logical, then add brackets
+		if _, ok := cw.(nsql.WhereLogicWriter); ok {
+			sb.WriteByte('(')
+			writeWhereState(sb, cw, st)
+			sb.WriteByte(')')
+			continue
+		}
+
+		writeWhereState(sb, cw, st)
+	}
+}
+
+func (w *WhereLogicWriter) GetConditions() []nsql.WhereWriter {
+	return w.conditions
+}
+
+// GetArgs returns values of conditions in order of written placeholders
+func (w *WhereLogicWriter) GetArgs() []interface{} {
+	var args []interface{}
+	for _, cw := range w.conditions {
+		if ag, ok := cw.(nsql.ArgsGetter); ok {
+			args = append(args, ag.GetArgs()...)
+		}
+	}
+	return args
+}
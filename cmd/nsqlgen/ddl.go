@@ -0,0 +1,450 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// table is a table definition that is parsed from CREATE TABLE statement
+type table struct {
+	namespace     string
+	name          string
+	columns       []column
+	primaryKeys   []string
+	autoIncrement bool
+}
+
+// column is a column definition in CREATE TABLE statement
+type column struct {
+	name          string
+	dataType      string
+	length        string
+	notNull       bool
+	primaryKey    bool
+	autoIncrement bool
+}
+
+// primaryKey returns primary key column of table
+func (t *table) primaryKey() (string, error) {
+	switch len(t.primaryKeys) {
+	case 0:
+		return "", fmt.Errorf(`table "%s" has no primary key`, t.name)
+	case 1:
+		return t.primaryKeys[0], nil
+	default:
+		return "", fmt.Errorf(`table "%s" has composite primary key %s, which is not supported by schema`,
+			t.name, strings.Join(t.primaryKeys, ", "))
+	}
+}
+
+// parseDDL parse CREATE TABLE statements from sql source of dialect. Other statements are ignored
+func parseDDL(src string, dialect string) ([]table, error) {
+	var tables []table
+	for _, stmt := range splitStatements(stripComments(src, dialect)) {
+		tokens := tokenize(stmt)
+
+		// Check if statement is a create table
+		pos, ok := matchCreateTable(tokens)
+		if !ok {
+			continue
+		}
+
+		t, err := parseCreateTable(tokens[pos:])
+		if err != nil {
+			return nil, err
+		}
+		tables = append(tables, *t)
+	}
+	return tables, nil
+}
+
+// matchCreateTable returns position of table name if tokens is a CREATE TABLE statement
+func matchCreateTable(tokens []token) (int, bool) {
+	if len(tokens) < 3 || !tokens[0].is("CREATE") {
+		return 0, false
+	}
+
+	// Skip table modifiers
+	i := 1
+	for i < len(tokens) && tokens[i].isAny("TEMP", "TEMPORARY", "UNLOGGED", "GLOBAL", "LOCAL") {
+		i++
+	}
+
+	if i >= len(tokens) || !tokens[i].is("TABLE") {
+		return 0, false
+	}
+	i++
+
+	// Skip IF NOT EXISTS
+	if i+2 < len(tokens) && tokens[i].is("IF") && tokens[i+1].is("NOT") && tokens[i+2].is("EXISTS") {
+		i += 3
+	}
+
+	return i, i < len(tokens)
+}
+
+func parseCreateTable(tokens []token) (*table, error) {
+	// Get table name, the last part of qualified name. The part before table name is a namespace
+	t := table{name: tokens[0].identifier()}
+	i := 1
+	for i+1 < len(tokens) && tokens[i].value == "." {
+		t.namespace = t.name
+		t.name = tokens[i+1].identifier()
+		i += 2
+	}
+
+	// Get columns definition
+	if i >= len(tokens) || tokens[i].kind != groupToken {
+		return nil, fmt.Errorf(`invalid CREATE TABLE statement for table "%s", columns definition is not found`, t.name)
+	}
+
+	for _, def := range splitTopLevel(tokens[i].inner(), ',') {
+		defTokens := tokenize(def)
+		if len(defTokens) == 0 {
+			continue
+		}
+
+		// Handle table constraint
+		if defTokens[0].isAny("CONSTRAINT", "PRIMARY", "UNIQUE", "FOREIGN", "CHECK", "KEY", "INDEX", "FULLTEXT",
+			"SPATIAL", "EXCLUDE") {
+			if pks, ok := parsePrimaryKeyConstraint(defTokens); ok {
+				t.primaryKeys = pks
+			}
+			continue
+		}
+
+		c, err := parseColumn(defTokens)
+		if err != nil {
+			return nil, fmt.Errorf(`invalid column definition in table "%s". %s`, t.name, err)
+		}
+		t.columns = append(t.columns, *c)
+
+		if c.primaryKey {
+			t.primaryKeys = []string{c.name}
+		}
+	}
+
+	// Set primary key flags on columns
+	for i, c := range t.columns {
+		for _, pk := range t.primaryKeys {
+			if c.name == pk {
+				t.columns[i].primaryKey = true
+				t.columns[i].notNull = true
+			}
+		}
+
+		if t.columns[i].primaryKey && c.autoIncrement {
+			t.autoIncrement = true
+		}
+	}
+
+	return &t, nil
+}
+
+// parsePrimaryKeyConstraint parse PRIMARY KEY (col1, ...) table constraint
+func parsePrimaryKeyConstraint(tokens []token) ([]string, bool) {
+	for i := 0; i+2 < len(tokens); i++ {
+		if !tokens[i].is("PRIMARY") || !tokens[i+1].is("KEY") || tokens[i+2].kind != groupToken {
+			continue
+		}
+
+		var pks []string
+		for _, col := range splitTopLevel(tokens[i+2].inner(), ',') {
+			colTokens := tokenize(col)
+			if len(colTokens) > 0 {
+				pks = append(pks, colTokens[0].identifier())
+			}
+		}
+		return pks, true
+	}
+	return nil, false
+}
+
+// multiWordTypes is a list of data type that is written in more than one word
+var multiWordTypes = map[string][]string{
+	"double":    {"precision"},
+	"character": {"varying"},
+	"bit":       {"varying"},
+	"timestamp": {"with", "time", "zone", "without"},
+	"time":      {"with", "time", "zone", "without"},
+}
+
+func parseColumn(tokens []token) (*column, error) {
+	if len(tokens) < 2 {
+		return nil, fmt.Errorf(`column "%s" has no data type`, tokens[0].identifier())
+	}
+
+	c := column{
+		name:     tokens[0].identifier(),
+		dataType: strings.ToLower(tokens[1].value),
+	}
+
+	// Resolve multi-word data type and length
+	i := 2
+	for i < len(tokens) {
+		tk := tokens[i]
+		if tk.kind == groupToken {
+			c.length = strings.TrimSpace(tk.inner())
+			i++
+			continue
+		}
+
+		if !isTypeSuffix(c.dataType, tk) {
+			break
+		}
+		c.dataType += " " + strings.ToLower(tk.value)
+		i++
+	}
+
+	// Remove array suffix from type, array is mapped as string
+	if strings.HasSuffix(c.dataType, "[]") {
+		c.dataType = "array"
+	}
+
+	// Normalize serial types
+	switch c.dataType {
+	case "serial", "bigserial", "smallserial", "serial4", "serial8", "serial2":
+		c.autoIncrement = true
+		c.notNull = true
+	}
+
+	// Evaluate column constraints
+	for ; i < len(tokens); i++ {
+		tk := tokens[i]
+		switch {
+		case tk.is("NOT") && i+1 < len(tokens) && tokens[i+1].is("NULL"):
+			c.notNull = true
+			i++
+		case tk.is("PRIMARY") && i+1 < len(tokens) && tokens[i+1].is("KEY"):
+			c.primaryKey = true
+			c.notNull = true
+			i++
+		case tk.isAny("AUTO_INCREMENT", "AUTOINCREMENT", "IDENTITY"):
+			c.autoIncrement = true
+		case tk.is("DEFAULT") && i+1 < len(tokens) && strings.HasPrefix(strings.ToLower(tokens[i+1].value), "nextval"):
+			c.autoIncrement = true
+		}
+	}
+
+	return &c, nil
+}
+
+func isTypeSuffix(dataType string, tk token) bool {
+	if tk.kind != wordToken {
+		return false
+	}
+
+	// Get base type
+	base := strings.SplitN(dataType, " ", 2)[0]
+	suffixes, ok := multiWordTypes[base]
+	if !ok {
+		return false
+	}
+
+	for _, s := range suffixes {
+		if tk.is(s) {
+			return true
+		}
+	}
+	return false
+}
+
+// Tokenizer
+
+type tokenKind uint8
+
+const (
+	wordToken tokenKind = iota
+	quotedToken
+	stringToken
+	groupToken
+	symbolToken
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+}
+
+func (t token) is(keyword string) bool {
+	return t.kind == wordToken && strings.EqualFold(t.value, keyword)
+}
+
+func (t token) isAny(keywords ...string) bool {
+	for _, k := range keywords {
+		if t.is(k) {
+			return true
+		}
+	}
+	return false
+}
+
+// identifier returns unquoted identifier
+func (t token) identifier() string {
+	if t.kind != quotedToken {
+		return t.value
+	}
+
+	// Unescape doubled quote
+	q := t.value[:1]
+	return strings.ReplaceAll(t.value[1:len(t.value)-1], q+q, q)
+}
+
+// inner returns content of a group token
+func (t token) inner() string {
+	return t.value[1 : len(t.value)-1]
+}
+
+// tokenize split statement to words, quoted identifiers, strings and parenthesis groups
+func tokenize(s string) []token {
+	var tokens []token
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case isSpace(c):
+			i++
+		case c == '"' || c == '`' || c == '[':
+			end := scanQuoted(s, i)
+			tokens = append(tokens, token{kind: quotedToken, value: normalizeBracket(s[i:end])})
+			i = end
+		case c == '\'':
+			end := scanQuoted(s, i)
+			tokens = append(tokens, token{kind: stringToken, value: s[i:end]})
+			i = end
+		case c == '(':
+			end := scanGroup(s, i)
+			tokens = append(tokens, token{kind: groupToken, value: s[i:end]})
+			i = end
+		case isWordChar(c):
+			end := i
+			for end < len(s) && (isWordChar(s[end]) || s[end] == '[' && end+1 < len(s) && s[end+1] == ']') {
+				if s[end] == '[' {
+					end++
+				}
+				end++
+			}
+			tokens = append(tokens, token{kind: wordToken, value: s[i:end]})
+			i = end
+		default:
+			tokens = append(tokens, token{kind: symbolToken, value: s[i : i+1]})
+			i++
+		}
+	}
+	return tokens
+}
+
+// normalizeBracket converts SQL Server bracket identifier to double-quoted identifier
+func normalizeBracket(s string) string {
+	if s[0] != '[' {
+		return s
+	}
+	return `"` + strings.ReplaceAll(s[1:len(s)-1], `"`, `""`) + `"`
+}
+
+// scanQuoted returns end position of quoted string that started at i. Doubled quote is treated as escaped quote
+func scanQuoted(s string, i int) int {
+	q := s[i]
+	if q == '[' {
+		q = ']'
+	}
+
+	for j := i + 1; j < len(s); j++ {
+		if s[j] != q {
+			continue
+		}
+		if j+1 < len(s) && s[j+1] == q && q != ']' {
+			j++
+			continue
+		}
+		return j + 1
+	}
+	return len(s)
+}
+
+// scanGroup returns end position of parenthesis group that started at i
+func scanGroup(s string, i int) int {
+	depth := 0
+	for j := i; j < len(s); j++ {
+		switch s[j] {
+		case '"', '`', '\'':
+			j = scanQuoted(s, j) - 1
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return j + 1
+			}
+		}
+	}
+	return len(s)
+}
+
+// splitTopLevel split s by separator that is not inside quotes or parenthesis
+func splitTopLevel(s string, sep byte) []string {
+	var parts []string
+	start := 0
+	for j := 0; j < len(s); j++ {
+		switch s[j] {
+		case '"', '`', '\'':
+			j = scanQuoted(s, j) - 1
+		case '(':
+			j = scanGroup(s, j) - 1
+		case sep:
+			parts = append(parts, s[start:j])
+			start = j + 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// splitStatements split source to statements by semicolon
+func splitStatements(s string) []string {
+	var stmts []string
+	for _, stmt := range splitTopLevel(s, ';') {
+		if stmt = strings.TrimSpace(stmt); stmt != "" {
+			stmts = append(stmts, stmt)
+		}
+	}
+	return stmts
+}
+
+// stripComments remove line and block comments from source. Line comment that is started with # is only removed in
+// MySQL, since # is an operator in PostgreSQL
+func stripComments(s string, dialect string) string {
+	hashComment := dialect == dialectMysql
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '"' || s[i] == '`' || s[i] == '\'':
+			end := scanQuoted(s, i)
+			b.WriteString(s[i:end])
+			i = end - 1
+		case strings.HasPrefix(s[i:], "--") || hashComment && s[i] == '#':
+			end := strings.IndexByte(s[i:], '\n')
+			if end < 0 {
+				return b.String()
+			}
+			i += end - 1
+		case strings.HasPrefix(s[i:], "/*"):
+			end := strings.Index(s[i+2:], "*/")
+			if end < 0 {
+				return b.String()
+			}
+			b.WriteByte(' ')
+			i += end + 3
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return b.String()
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+func isWordChar(c byte) bool {
+	return c == '_' || c == '$' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"github.com/nbs-go/nsql/test_utils"
+	"testing"
+)
+
+func TestParseDDL_Postgres(t *testing.T) {
+	src := `
+-- Customer table
+CREATE TABLE IF NOT EXISTS public."Customer" (
+	"id" BIGSERIAL PRIMARY KEY,
+	"createdAt" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+	"fullName" VARCHAR(255) NOT NULL,
+	"balance" NUMERIC(10, 2) NOT NULL DEFAULT 0,
+	"nickName" TEXT, /* nullable */
+	CONSTRAINT "idx_Customer_fullName" UNIQUE ("fullName")
+);
+
+CREATE INDEX "idx_Customer_createdAt" ON "Customer" ("createdAt");
+`
+	tables, err := parseDDL(src, dialectPostgres)
+	if err != nil {
+		t.Errorf("Unexpected error. Error=%s", err)
+		return
+	}
+
+	test_utils.CompareInt(t, "TABLE COUNT", len(tables), 1)
+
+	c := tables[0]
+	test_utils.CompareString(t, "TABLE NAME", c.name, "Customer")
+	test_utils.CompareString(t, "NAMESPACE", c.namespace, "public")
+	test_utils.CompareInt(t, "COLUMN COUNT", len(c.columns), 5)
+	test_utils.CompareStringArray(t, "PRIMARY KEYS", c.primaryKeys, []string{"id"})
+	test_utils.CompareBoolean(t, "AUTO INCREMENT", c.autoIncrement, true)
+	test_utils.CompareString(t, "MULTI WORD TYPE", c.columns[1].dataType, "timestamp with time zone")
+	test_utils.CompareString(t, "TYPE LENGTH", c.columns[3].length, "10, 2")
+	test_utils.CompareBoolean(t, "NOT NULL", c.columns[2].notNull, true)
+	test_utils.CompareBoolean(t, "NULLABLE", c.columns[4].notNull, false)
+}
+
+func TestParseDDL_Mysql(t *testing.T) {
+	src := "CREATE TABLE `order_item` (\n" +
+		"  `order_id` bigint NOT NULL,\n" +
+		"  `sku` varchar(32) NOT NULL,\n" +
+		"  `is_gift` tinyint(1) NOT NULL DEFAULT '0',\n" +
+		"  PRIMARY KEY (`sku`),\n" +
+		"  KEY `idx_order_id` (`order_id`)\n" +
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;\n" +
+		"CREATE TABLE log (id int unsigned NOT NULL AUTO_INCREMENT, message text, PRIMARY KEY (id));"
+
+	tables, err := parseDDL(src, dialectMysql)
+	if err != nil {
+		t.Errorf("Unexpected error. Error=%s", err)
+		return
+	}
+
+	test_utils.CompareInt(t, "TABLE COUNT", len(tables), 2)
+	test_utils.CompareString(t, "BACKTICK TABLE NAME", tables[0].name, "order_item")
+	test_utils.CompareStringArray(t, "TABLE CONSTRAINT PRIMARY KEY", tables[0].primaryKeys, []string{"sku"})
+	test_utils.CompareBoolean(t, "NO AUTO INCREMENT", tables[0].autoIncrement, false)
+	test_utils.CompareString(t, "TINYINT LENGTH", tables[0].columns[2].length, "1")
+	test_utils.CompareBoolean(t, "AUTO INCREMENT", tables[1].autoIncrement, true)
+}
+
+func TestParseDDL_HashComment(t *testing.T) {
+	// PostgreSQL use # as bitwise XOR operator
+	tables, err := parseDDL(`CREATE TABLE "Flag" ("id" int PRIMARY KEY, "bits" int CHECK (bits # 1 >= 0), "name" text)`,
+		dialectPostgres)
+	if err != nil {
+		t.Errorf("Unexpected error. Error=%s", err)
+		return
+	}
+	test_utils.CompareInt(t, "POSTGRES HASH OPERATOR", len(tables[0].columns), 3)
+
+	// MySQL use # as line comment
+	tables, err = parseDDL("CREATE TABLE flag (\n  id int PRIMARY KEY, # primary key\n  name text\n)", dialectMysql)
+	if err != nil {
+		t.Errorf("Unexpected error. Error=%s", err)
+		return
+	}
+	test_utils.CompareInt(t, "MYSQL HASH COMMENT", len(tables[0].columns), 2)
+}
+
+func TestParseDDL_CompositePrimaryKey(t *testing.T) {
+	tables, err := parseDDL(`CREATE TABLE "Tag" ("contentId" int, "tag" text, PRIMARY KEY ("contentId", "tag"))`, dialectPostgres)
+	if err != nil {
+		t.Errorf("Unexpected error. Error=%s", err)
+		return
+	}
+
+	_, err = tables[0].primaryKey()
+	if err == nil {
+		t.Errorf("Unexpected result. Function must return error")
+		return
+	}
+	test_utils.CompareString(t, "COMPOSITE PRIMARY KEY", err.Error(),
+		`table "Tag" has composite primary key contentId, tag, which is not supported by schema`)
+}
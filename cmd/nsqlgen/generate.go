@@ -0,0 +1,212 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	dialectPostgres = "pq"
+	dialectMysql    = "mysql"
+)
+
+// goType is a go data type that is mapped from column data type
+type goType struct {
+	name     string
+	nullable string
+	imports  []string
+}
+
+var (
+	intType     = goType{name: "int64", nullable: "sql.NullInt64"}
+	boolType    = goType{name: "bool", nullable: "sql.NullBool"}
+	floatType   = goType{name: "float64", nullable: "sql.NullFloat64"}
+	timeType    = goType{name: "time.Time", nullable: "sql.NullTime", imports: []string{"time"}}
+	stringType  = goType{name: "string", nullable: "sql.NullString"}
+	jsonType    = goType{name: "json.RawMessage", nullable: "json.RawMessage", imports: []string{"encoding/json"}}
+	bytesType   = goType{name: "[]byte", nullable: "[]byte"}
+	schemaPkg   = "github.com/nbs-go/nsql/schema"
+	databaseSql = "database/sql"
+)
+
+// mapType resolve go type from column data type
+func mapType(c column, dialect string) goType {
+	// Get base type, without modifiers
+	base := strings.SplitN(c.dataType, " ", 2)[0]
+
+	switch base {
+	case "tinyint":
+		// MySQL declare boolean as tinyint(1)
+		if dialect == dialectMysql && c.length == "1" {
+			return boolType
+		}
+		return intType
+	case "smallint", "mediumint", "int", "integer", "bigint", "int2", "int4", "int8", "serial", "bigserial",
+		"smallserial", "serial2", "serial4", "serial8", "year":
+		return intType
+	case "bool", "boolean", "bit":
+		return boolType
+	case "real", "float", "float4", "float8", "double":
+		return floatType
+	case "numeric", "decimal", "money":
+		return stringType
+	case "date", "datetime", "timestamp", "timestamptz":
+		return timeType
+	case "json", "jsonb":
+		return jsonType
+	case "bytea", "blob", "tinyblob", "mediumblob", "longblob", "binary", "varbinary":
+		return bytesType
+	default:
+		return stringType
+	}
+}
+
+// generate write go source code of models, schemas and column name constants
+func generate(tables []table, pkg string, dialect string) ([]byte, error) {
+	imports := map[string]bool{schemaPkg: true}
+	names := make(map[string]string)
+	var body bytes.Buffer
+
+	for _, t := range tables {
+		pk, err := t.primaryKey()
+		if err != nil {
+			return nil, err
+		}
+
+		structName := exportedName(t.name)
+
+		// Check if columns are mapped to the same field
+		fields := make(map[string]string, len(t.columns))
+		for _, c := range t.columns {
+			field := exportedName(c.name)
+			if prev, ok := fields[field]; ok {
+				return nil, fmt.Errorf(`table "%s" has columns "%s" and "%s" that are mapped to the same field %s`,
+					t.name, prev, c.name, field)
+			}
+			fields[field] = c.name
+		}
+
+		// Check if generated identifiers collide with identifiers of other tables
+		if err := declareName(names, structName, fmt.Sprintf(`table "%s"`, t.name)); err != nil {
+			return nil, err
+		}
+		if err := declareName(names, structName+"Schema", fmt.Sprintf(`schema of table "%s"`, t.name)); err != nil {
+			return nil, err
+		}
+		for _, c := range t.columns {
+			err := declareName(names, structName+"Col"+exportedName(c.name),
+				fmt.Sprintf(`column "%s" of table "%s"`, c.name, t.name))
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		// Write model
+		fmt.Fprintf(&body, "// %s is a model of table %s\n", structName, t.name)
+		fmt.Fprintf(&body, "type %s struct {\n", structName)
+		for _, c := range t.columns {
+			gt := mapType(c, dialect)
+
+			// Resolve nullable type
+			typeName := gt.name
+			if !c.notNull {
+				typeName = gt.nullable
+			}
+
+			if strings.HasPrefix(typeName, "sql.") {
+				imports[databaseSql] = true
+			}
+			for _, imp := range gt.imports {
+				imports[imp] = true
+			}
+
+			fmt.Fprintf(&body, "%s %s `db:%q`\n", exportedName(c.name), typeName, c.name)
+		}
+		body.WriteString("}\n\n")
+
+		// Write column name constants
+		fmt.Fprintf(&body, "// %s column names\n", structName)
+		body.WriteString("const (\n")
+		for _, c := range t.columns {
+			fmt.Fprintf(&body, "%sCol%s = %q\n", structName, exportedName(c.name), c.name)
+		}
+		body.WriteString(")\n\n")
+
+		// Write schema
+		schemaArgs := []string{fmt.Sprintf("schema.FromModelRef(%s{})", structName)}
+		if t.name != structName {
+			schemaArgs = append(schemaArgs, fmt.Sprintf("schema.TableName(%q)", t.name))
+		}
+		// Skip PostgreSQL default schema, so table is resolved with search path
+		if t.namespace != "" && !(dialect == dialectPostgres && t.namespace == "public") {
+			schemaArgs = append(schemaArgs, fmt.Sprintf("schema.Namespace(%q)", t.namespace))
+		}
+		if pk != "id" {
+			schemaArgs = append(schemaArgs, fmt.Sprintf("schema.PrimaryKey(%sCol%s)", structName, exportedName(pk)))
+		}
+		if !t.autoIncrement {
+			schemaArgs = append(schemaArgs, "schema.AutoIncrement(false)")
+		}
+
+		fmt.Fprintf(&body, "// %sSchema is a schema of table %s\n", structName, t.name)
+		fmt.Fprintf(&body, "var %sSchema = schema.New(%s)\n\n", structName, strings.Join(schemaArgs, ", "))
+	}
+
+	// Write header
+	var src bytes.Buffer
+	src.WriteString("// Code generated by nsqlgen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&src, "package %s\n\n", pkg)
+
+	importList := make([]string, 0, len(imports))
+	for imp := range imports {
+		importList = append(importList, imp)
+	}
+	sort.Strings(importList)
+
+	src.WriteString("import (\n")
+	for _, imp := range importList {
+		fmt.Fprintf(&src, "%q\n", imp)
+	}
+	src.WriteString(")\n\n")
+	src.Write(body.Bytes())
+
+	return format.Source(src.Bytes())
+}
+
+// declareName register identifier that is generated from source. It returns error if identifier has been generated
+// from other source
+func declareName(names map[string]string, name string, source string) error {
+	if prev, ok := names[name]; ok {
+		return fmt.Errorf("%s and %s are generated as the same identifier %s", prev, source, name)
+	}
+	names[name] = source
+	return nil
+}
+
+// exportedName convert table or column name to exported go identifier
+func exportedName(s string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+
+		// Identifier cannot be started with digit
+		if b.Len() == 0 && unicode.IsDigit(r) {
+			b.WriteByte('X')
+		}
+
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
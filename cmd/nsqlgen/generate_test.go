@@ -0,0 +1,132 @@
+package main
+
+import (
+	"github.com/nbs-go/nsql/test_utils"
+	"strings"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	tables, err := parseDDL(`CREATE TABLE "customer_log" (
+		"logId" varchar(36) PRIMARY KEY,
+		"createdAt" timestamptz NOT NULL,
+		"payload" jsonb,
+		"isRead" boolean
+	)`, dialectPostgres)
+	if err != nil {
+		t.Errorf("Unexpected error. Error=%s", err)
+		return
+	}
+
+	actual, err := generate(tables, "model", dialectPostgres)
+	if err != nil {
+		t.Errorf("Unexpected error. Error=%s", err)
+		return
+	}
+
+	expected := "// Code generated by nsqlgen. DO NOT EDIT.\n" +
+		"\n" +
+		"package model\n" +
+		"\n" +
+		"import (\n" +
+		"\t\"database/sql\"\n" +
+		"\t\"encoding/json\"\n" +
+		"\t\"github.com/nbs-go/nsql/schema\"\n" +
+		"\t\"time\"\n" +
+		")\n" +
+		"\n" +
+		"// CustomerLog is a model of table customer_log\n" +
+		"type CustomerLog struct {\n" +
+		"\tLogId     string          `db:\"logId\"`\n" +
+		"\tCreatedAt time.Time       `db:\"createdAt\"`\n" +
+		"\tPayload   json.RawMessage `db:\"payload\"`\n" +
+		"\tIsRead    sql.NullBool    `db:\"isRead\"`\n" +
+		"}\n" +
+		"\n" +
+		"// CustomerLog column names\n" +
+		"const (\n" +
+		"\tCustomerLogColLogId     = \"logId\"\n" +
+		"\tCustomerLogColCreatedAt = \"createdAt\"\n" +
+		"\tCustomerLogColPayload   = \"payload\"\n" +
+		"\tCustomerLogColIsRead    = \"isRead\"\n" +
+		")\n" +
+		"\n" +
+		"// CustomerLogSchema is a schema of table customer_log\n" +
+		"var CustomerLogSchema = schema.New(schema.FromModelRef(CustomerLog{}), schema.TableName(\"customer_log\"), " +
+		"schema.PrimaryKey(CustomerLogColLogId), schema.AutoIncrement(false))\n"
+
+	test_utils.CompareString(t, "GENERATED SOURCE", string(actual), expected)
+}
+
+func TestGenerate_NoPrimaryKey(t *testing.T) {
+	tables, _ := parseDDL(`CREATE TABLE "Audit" ("message" text)`, dialectPostgres)
+	_, err := generate(tables, "model", dialectPostgres)
+	if err == nil {
+		t.Errorf("Unexpected result. Function must return error")
+		return
+	}
+	test_utils.CompareString(t, "NO PRIMARY KEY", err.Error(), `table "Audit" has no primary key`)
+}
+
+func TestGenerate_FieldNameCollision(t *testing.T) {
+	tables, _ := parseDDL(`CREATE TABLE "Audit" ("id" bigserial PRIMARY KEY, "created_at" timestamptz, "createdAt" timestamptz)`,
+		dialectPostgres)
+	_, err := generate(tables, "model", dialectPostgres)
+	if err == nil {
+		t.Errorf("Unexpected result. Function must return error")
+		return
+	}
+	test_utils.CompareString(t, "FIELD NAME COLLISION", err.Error(),
+		`table "Audit" has columns "created_at" and "createdAt" that are mapped to the same field CreatedAt`)
+}
+
+func TestGenerate_TableNameCollision(t *testing.T) {
+	tables, _ := parseDDL(`CREATE TABLE "user_account" ("id" bigserial PRIMARY KEY);
+		CREATE TABLE "UserAccount" ("id" bigserial PRIMARY KEY)`, dialectPostgres)
+	_, err := generate(tables, "model", dialectPostgres)
+	if err == nil {
+		t.Errorf("Unexpected result. Function must return error")
+		return
+	}
+	test_utils.CompareString(t, "TABLE NAME COLLISION", err.Error(),
+		`table "user_account" and table "UserAccount" are generated as the same identifier UserAccount`)
+
+	// Column name constant of a table collide with struct of other table
+	tables, _ = parseDDL(`CREATE TABLE "user" ("id" bigserial PRIMARY KEY, "name" text);
+		CREATE TABLE "user_col_name" ("id" bigserial PRIMARY KEY)`, dialectPostgres)
+	_, err = generate(tables, "model", dialectPostgres)
+	if err == nil {
+		t.Errorf("Unexpected result. Function must return error")
+		return
+	}
+	test_utils.CompareString(t, "CONSTANT NAME COLLISION", err.Error(),
+		`column "name" of table "user" and table "user_col_name" are generated as the same identifier UserColName`)
+}
+
+func TestMapType_ExactNumeric(t *testing.T) {
+	for _, dataType := range []string{"numeric", "decimal", "money"} {
+		test_utils.CompareString(t, "EXACT NUMERIC "+dataType, mapType(column{dataType: dataType}, dialectPostgres).name, "string")
+	}
+	test_utils.CompareString(t, "FLOAT", mapType(column{dataType: "double"}, dialectMysql).name, "float64")
+}
+
+func TestExportedName(t *testing.T) {
+	test_utils.CompareString(t, "SNAKE CASE", exportedName("order_item"), "OrderItem")
+	test_utils.CompareString(t, "CAMEL CASE", exportedName("createdAt"), "CreatedAt")
+	test_utils.CompareString(t, "LEADING DIGIT", exportedName("2fa_code"), "X2faCode")
+}
+
+func TestGenerate_Namespace(t *testing.T) {
+	tables, _ := parseDDL(`CREATE TABLE billing."Invoice" ("id" bigserial PRIMARY KEY);
+		CREATE TABLE public."Customer" ("id" bigserial PRIMARY KEY)`, dialectPostgres)
+	actual, err := generate(tables, "model", dialectPostgres)
+	if err != nil {
+		t.Errorf("Unexpected error. Error=%s", err)
+		return
+	}
+
+	test_utils.CompareBoolean(t, "QUALIFIED TABLE", strings.Contains(string(actual),
+		`var InvoiceSchema = schema.New(schema.FromModelRef(Invoice{}), schema.Namespace("billing"))`), true)
+	test_utils.CompareBoolean(t, "DEFAULT SCHEMA", strings.Contains(string(actual),
+		`var CustomerSchema = schema.New(schema.FromModelRef(Customer{}))`), true)
+}
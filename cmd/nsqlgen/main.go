@@ -0,0 +1,73 @@
+// Command nsqlgen generates go models, schemas and column name constants from CREATE TABLE statements.
+//
+// Usage:
+//
+//	nsqlgen -in schema.sql -out model/schema_gen.go -pkg model -dialect pq
+//
+// If -in or -out is not set, nsqlgen will read from stdin and write to stdout.
+//
+// Columns of numeric, decimal and money types are generated as string, so values are not rounded by float64.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+)
+
+func main() {
+	in := flag.String("in", "", "SQL DDL input file, default to stdin")
+	out := flag.String("out", "", "Go output file, default to stdout")
+	pkg := flag.String("pkg", "model", "Package name of generated file")
+	dialect := flag.String("dialect", dialectPostgres, "SQL dialect of DDL, pq or mysql")
+	flag.Parse()
+
+	if err := run(*in, *out, *pkg, *dialect); err != nil {
+		fmt.Fprintf(os.Stderr, "nsqlgen: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(in, out, pkg, dialect string) error {
+	// Validate dialect
+	switch dialect {
+	case dialectPostgres, dialectMysql:
+	default:
+		return fmt.Errorf("unsupported dialect %s", dialect)
+	}
+
+	// Read input
+	var src []byte
+	var err error
+	if in == "" {
+		src, err = io.ReadAll(os.Stdin)
+	} else {
+		src, err = os.ReadFile(in)
+	}
+	if err != nil {
+		return err
+	}
+
+	// Parse
+	tables, err := parseDDL(string(src), dialect)
+	if err != nil {
+		return err
+	}
+
+	if len(tables) == 0 {
+		return fmt.Errorf("no CREATE TABLE statement found")
+	}
+
+	// Generate
+	result, err := generate(tables, pkg, dialect)
+	if err != nil {
+		return err
+	}
+
+	if out == "" {
+		_, err = os.Stdout.Write(result)
+		return err
+	}
+	return os.WriteFile(out, result, 0644)
+}
@@ -0,0 +1,152 @@
+package nsql
+
+import (
+	"github.com/nbs-go/nsql/op"
+	"strings"
+)
+
+// Dialect defines SQL syntax of a database that is used by query builder to write query
+type Dialect interface {
+	// Name returns name of database. It is used in error message
+	Name() string
+	// QuoteIdent returns quoted identifier, such as table, column and alias name. Quote character in name must be
+	// escaped, so name can not break out from quoted identifier
+	QuoteIdent(name string) string
+	// QuoteLiteral returns escaped string literal that is enclosed by single quotes, such as JSON attribute key
+	QuoteLiteral(s string) string
+	// BindType returns placeholder format that is expected by database driver
+	BindType() op.VariableFormat
+	// Operator returns keyword of operator. Dialect may rewrite operator that is not supported, such as ILIKE to LIKE
+	Operator(o op.Operator) string
+	// WriteLimitQuery write LIMIT and OFFSET clause with leading space that is appended to SELECT query. Limit or
+	// offset is nil if it is not set
+	WriteLimitQuery(sb *strings.Builder, limit *int64, offset *int64)
+	// Supports returns true if database supports feature
+	Supports(f Feature) bool
+}
+
+// JSONExtractor is implemented by Dialect that extract JSON attribute with a function instead of -> and ->> operators
+type JSONExtractor interface {
+	// JSONExtract returns expression that extract text value of nested attributes from column
+	JSONExtract(column string, attrs []string) string
+}
+
+// Paginator is implemented by Dialect that writes pagination in other form than LIMIT and OFFSET clause at the end of
+// SELECT query. If it is implemented, then WriteLimitQuery is not used by SELECT query
+type Paginator interface {
+	// WritePaginationPrefix write clause with trailing space that is written after SELECT keyword, such as TOP
+	WritePaginationPrefix(sb *strings.Builder, limit *int64, offset *int64)
+	// WritePaginationSuffix write clause with leading space that is appended to SELECT query. Ordered is true if ORDER
+	// BY clause is written. It returns error if pagination can not be written
+	WritePaginationSuffix(sb *strings.Builder, limit *int64, offset *int64, ordered bool) error
+}
+
+// ArrayBinder is implemented by Dialect that can bind a slice as an array, so IN condition can be written as "= ANY(?)"
+type ArrayBinder interface {
+	BindArray(values interface{}) interface{}
+}
+
+// BooleanWriter is implemented by Dialect that has no boolean type in expression, such as SQL Server. Boolean values
+// and predicates in SELECT are written as values of bit type
+type BooleanWriter interface {
+	// BoolLiteral returns literal of boolean value
+	BoolLiteral(v bool) string
+	// SelectPredicate returns expression that selects result of predicate as a value
+	SelectPredicate(predicate string) string
+}
+
+// Feature is a query feature that is not supported by all databases
+type Feature uint8
+
+const (
+	// FeatureReturning write RETURNING clause of primary key in INSERT query
+	FeatureReturning Feature = iota
+	// FeatureJSON write JSON attribute with -> and ->> operators, or with JSONExtractor if it is implemented by Dialect
+	FeatureJSON
+	// FeatureUpsert write ON CONFLICT clause in INSERT query
+	FeatureUpsert
+	// FeatureOutputInserted write OUTPUT INSERTED clause of primary key in INSERT query instead of RETURNING clause
+	FeatureOutputInserted
+	// FeatureMerge write INSERT query with ON CONFLICT declaration as MERGE statement
+	FeatureMerge
+	// FeaturePositionalVar write bind variables as positional placeholders of BindType if variable format is not set
+	// by option, since database driver does not support ? and named variables
+	FeaturePositionalVar
+)
+
+// StandardDialect writes standard SQL with double-quoted identifiers and ? bind variables. Embed it to implement
+// Dialect that only differs in a few parts
+type StandardDialect struct{}
+
+func (StandardDialect) Name() string {
+	return "SQL"
+}
+
+func (StandardDialect) QuoteIdent(name string) string {
+	return QuoteIdent(name, '"', '"')
+}
+
+func (StandardDialect) QuoteLiteral(s string) string {
+	return QuoteLiteral(s)
+}
+
+func (StandardDialect) BindType() op.VariableFormat {
+	return op.BindVar
+}
+
+func (StandardDialect) Operator(o op.Operator) string {
+	switch o {
+	case op.Equal:
+		return "="
+	case op.NotEqual:
+		return "!="
+	case op.GreaterThan:
+		return ">"
+	case op.GreaterThanEqual:
+		return ">="
+	case op.LessThan:
+		return "<"
+	case op.LessThanEqual:
+		return "<="
+	case op.Like:
+		return "LIKE"
+	case op.NotLike:
+		return "NOT LIKE"
+	case op.ILike:
+		return "ILIKE"
+	case op.NotILike:
+		return "NOT ILIKE"
+	case op.Between:
+		return "BETWEEN"
+	case op.NotBetween:
+		return "NOT BETWEEN"
+	case op.In:
+		return "IN"
+	case op.NotIn:
+		return "NOT IN"
+	case op.Is:
+		return "IS"
+	case op.IsNot:
+		return "IS NOT"
+	case op.And:
+		return "AND"
+	case op.Or:
+		return "OR"
+	}
+	return ""
+}
+
+func (StandardDialect) WriteLimitQuery(sb *strings.Builder, limit *int64, offset *int64) {
+	if limit != nil {
+		sb.WriteString(" LIMIT ")
+		WriteInt(sb, *limit)
+	}
+	if offset != nil {
+		sb.WriteString(" OFFSET ")
+		WriteInt(sb, *offset)
+	}
+}
+
+func (StandardDialect) Supports(_ Feature) bool {
+	return false
+}
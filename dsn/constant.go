@@ -3,4 +3,6 @@ package dsn
 const (
 	DriverPostgres = "postgres"
 	DriverMysql    = "mysql"
+	DriverSqlite   = "sqlite3"
+	DriverMssql    = "sqlserver"
 )
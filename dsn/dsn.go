@@ -3,12 +3,17 @@ package dsn
 import (
 	"fmt"
 	"net/url"
+	"strings"
 )
 
 func NormalizeDriver(d string) string {
 	switch d {
 	case "postgresql", "pg":
 		return DriverPostgres
+	case "sqlite":
+		return DriverSqlite
+	case "mssql":
+		return DriverMssql
 	}
 	return d
 }
@@ -18,6 +23,7 @@ func Format(driver, username, password, host string, port int, dbName string, ar
 
 	q := make(url.Values)
 	var scheme string
+	path := "/" + dbName
 
 	switch driver {
 	case DriverPostgres:
@@ -33,13 +39,21 @@ func Format(driver, username, password, host string, port int, dbName string, ar
 		scheme = "mysql"
 		q.Set("sslmode", fmt.Sprintf("%t", o.SslMode))
 		q.Set("parseTime", fmt.Sprintf("%t", o.ParseTime))
+	case DriverMssql, "mssql":
+		// SQL Server set database name in query parameter, path is reserved for instance name
+		scheme = "sqlserver"
+		path = ""
+		q.Set("database", dbName)
+		if !o.SslMode {
+			q.Set("encrypt", "disable")
+		}
 	default:
 		return "", fmt.Errorf("nsql: Unsupported driver %s", driver)
 	}
 
 	password = url.QueryEscape(password)
 
-	u, err := url.Parse(fmt.Sprintf("%s://%s:%s@%s:%d/%s", scheme, username, password, host, port, dbName))
+	u, err := url.Parse(fmt.Sprintf("%s://%s:%s@%s:%d%s", scheme, username, password, host, port, path))
 	if err != nil {
 		return "", err
 	}
@@ -47,3 +61,39 @@ func Format(driver, username, password, host string, port int, dbName string, ar
 	u.RawQuery = q.Encode()
 	return u.String(), nil
 }
+
+// FormatSqlite returns SQLite URI of database file. Use SqliteMode and SharedCache option setters to set open mode and
+// cache of database
+func FormatSqlite(path string, args ...OptionSetter) (string, error) {
+	if path == "" {
+		return "", fmt.Errorf("nsql: SQLite database path is empty")
+	}
+	o := evaluateOptions(args)
+	return sqliteURI(sqlitePathEscaper.Replace(path), o), nil
+}
+
+// FormatSqliteMemory returns SQLite URI of a named in-memory database. Cache is shared by default, so connections of
+// sql.DB pool use the same database
+func FormatSqliteMemory(name string, args ...OptionSetter) string {
+	o := evaluateOptions(append([]OptionSetter{SharedCache(true)}, args...))
+	o.SqliteMode = "memory"
+	return sqliteURI(sqlitePathEscaper.Replace(name), o)
+}
+
+var sqlitePathEscaper = strings.NewReplacer("%", "%25", "?", "%3F", "#", "%23")
+
+func sqliteURI(path string, o Options) string {
+	q := make(url.Values)
+	if o.SqliteMode != "" {
+		q.Set("mode", o.SqliteMode)
+	}
+	if o.SharedCache {
+		q.Set("cache", "shared")
+	}
+
+	u := "file:" + path
+	if len(q) > 0 {
+		u += "?" + q.Encode()
+	}
+	return u
+}
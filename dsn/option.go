@@ -1,9 +1,11 @@
 package dsn
 
 type Options struct {
-	SslMode    bool
-	ParseTime  bool
-	SearchPath string
+	SslMode     bool
+	ParseTime   bool
+	SearchPath  string
+	SqliteMode  string
+	SharedCache bool
 }
 
 type OptionSetter func(o *Options)
@@ -20,6 +22,20 @@ func SearchPath(path string) OptionSetter {
 	}
 }
 
+// SqliteMode set open mode of SQLite database, such as ro, rw, rwc or memory
+func SqliteMode(mode string) OptionSetter {
+	return func(o *Options) {
+		o.SqliteMode = mode
+	}
+}
+
+// SharedCache enable shared cache of SQLite database
+func SharedCache(enabled bool) OptionSetter {
+	return func(o *Options) {
+		o.SharedCache = enabled
+	}
+}
+
 func evaluateOptions(args []OptionSetter) Options {
 	// Init default value for options
 	opts := Options{
@@ -0,0 +1,192 @@
+package nsql
+
+import (
+	mysqlErr "github.com/nbs-go/nsql/mysql/error"
+	pqErr "github.com/nbs-go/nsql/pq/error"
+	"github.com/nbs-go/nsql/schema"
+	"sync"
+)
+
+// ErrorCode is a dialect-neutral classification of database error. Values are equal to Code in pq/error, Code in
+// mysql/error is mapped explicitly
+type ErrorCode int8
+
+const (
+	UnknownError               = ErrorCode(pqErr.UnknownError)
+	UnhandledError             = ErrorCode(pqErr.UnhandledError)
+	UniqueError                = ErrorCode(pqErr.UniqueError)
+	FkViolationError           = ErrorCode(pqErr.FkViolationError)
+	SerializationFailureError  = ErrorCode(pqErr.SerializationFailureError)
+	DeadlockError              = ErrorCode(pqErr.DeadlockError)
+	NotNullViolationError      = ErrorCode(pqErr.NotNullViolationError)
+	CheckViolationError        = ErrorCode(pqErr.CheckViolationError)
+	ExclusionViolationError    = ErrorCode(pqErr.ExclusionViolationError)
+	LockTimeoutError           = ErrorCode(pqErr.LockTimeoutError)
+	QueryCanceledError         = ErrorCode(pqErr.QueryCanceledError)
+	UndefinedTableError        = ErrorCode(pqErr.UndefinedTableError)
+	UndefinedColumnError       = ErrorCode(pqErr.UndefinedColumnError)
+	InsufficientPrivilegeError = ErrorCode(pqErr.InsufficientPrivilegeError)
+	ConnectionError            = ErrorCode(pqErr.ConnectionError)
+)
+
+// ErrorMetadata is metadata of database error that is available in both Postgres and MySQL
+type ErrorMetadata struct {
+	Constraint string
+	Message    string
+	Table      string
+	Column     string
+}
+
+// ParseError classify error from Postgres or MySQL driver. If error is not a database error, then UnknownError is
+// returned with nil metadata
+func ParseError(err error) (ErrorCode, *ErrorMetadata) {
+	if code, meta := pqErr.Parse(err); code != pqErr.UnknownError {
+		return ErrorCode(code), &ErrorMetadata{
+			Constraint: meta.Constraint,
+			Message:    meta.Message,
+			Table:      meta.Table,
+			Column:     meta.Column,
+		}
+	}
+
+	if code, meta := mysqlErr.Parse(err); code != mysqlErr.UnknownError {
+		return mysqlErrorCode(code), &ErrorMetadata{
+			Constraint: meta.Constraint,
+			Message:    meta.Message,
+			Table:      meta.Table,
+			Column:     meta.Column,
+		}
+	}
+
+	return UnknownError, nil
+}
+
+// mysqlErrorCode returns ErrorCode of error code in mysql/error
+func mysqlErrorCode(code mysqlErr.Code) ErrorCode {
+	switch code {
+	case mysqlErr.UnhandledError:
+		return UnhandledError
+	case mysqlErr.UniqueError:
+		return UniqueError
+	case mysqlErr.FkViolationError:
+		return FkViolationError
+	case mysqlErr.SerializationFailureError:
+		return SerializationFailureError
+	case mysqlErr.DeadlockError:
+		return DeadlockError
+	case mysqlErr.NotNullViolationError:
+		return NotNullViolationError
+	case mysqlErr.CheckViolationError:
+		return CheckViolationError
+	case mysqlErr.ExclusionViolationError:
+		return ExclusionViolationError
+	case mysqlErr.LockTimeoutError:
+		return LockTimeoutError
+	case mysqlErr.QueryCanceledError:
+		return QueryCanceledError
+	case mysqlErr.UndefinedTableError:
+		return UndefinedTableError
+	case mysqlErr.UndefinedColumnError:
+		return UndefinedColumnError
+	case mysqlErr.InsufficientPrivilegeError:
+		return InsufficientPrivilegeError
+	case mysqlErr.ConnectionError:
+		return ConnectionError
+	}
+	return UnknownError
+}
+
+// FieldError is a database error that is translated to a validation error of a column
+type FieldError struct {
+	Code    ErrorCode
+	Column  string
+	Message string
+	Err     error
+}
+
+func (e *FieldError) Error() string {
+	return e.Message
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// ConstraintRegistry maps constraint names to columns and user-facing messages, so database error can be translated to
+// FieldError
+type ConstraintRegistry struct {
+	mu          sync.RWMutex
+	constraints map[string]constraint
+}
+
+type constraint struct {
+	column  string
+	message string
+}
+
+// NewConstraintRegistry create an empty ConstraintRegistry
+func NewConstraintRegistry() *ConstraintRegistry {
+	return &ConstraintRegistry{
+		constraints: make(map[string]constraint),
+	}
+}
+
+// Register map constraint name to column in schema. If message is empty, then message is generated from column name and
+// error code, e.g. "email already exists". Register will panic if column is not declared in schema
+func (r *ConstraintRegistry) Register(s *schema.Schema, name string, column string, message string) *ConstraintRegistry {
+	if !s.IsColumnExist(column) {
+		panic(NewBuildError(ErrUnknownColumn, `nsql: column "%s" is not declared in table "%s"`, column, s.TableName()))
+	}
+
+	r.mu.Lock()
+	r.constraints[name] = constraint{column: column, message: message}
+	r.mu.Unlock()
+	return r
+}
+
+// Resolve translate database error to FieldError. Error is resolved by registered constraint name, or by column in
+// error metadata on not-null violation. If error cannot be resolved, then it will return false
+func (r *ConstraintRegistry) Resolve(err error) (*FieldError, bool) {
+	code, meta := ParseError(err)
+	if meta == nil {
+		return nil, false
+	}
+
+	// Get column and message by constraint
+	r.mu.RLock()
+	c, ok := r.constraints[meta.Constraint]
+	r.mu.RUnlock()
+
+	if !ok || meta.Constraint == "" {
+		if code != NotNullViolationError || meta.Column == "" {
+			return nil, false
+		}
+		c = constraint{column: meta.Column}
+	}
+
+	// Set default message
+	message := c.message
+	if message == "" {
+		message = defaultFieldMessage(code, c.column)
+	}
+
+	return &FieldError{
+		Code:    code,
+		Column:  c.column,
+		Message: message,
+		Err:     err,
+	}, true
+}
+
+func defaultFieldMessage(code ErrorCode, column string) string {
+	switch code {
+	case UniqueError, ExclusionViolationError:
+		return column + " already exists"
+	case FkViolationError:
+		return column + " is not found"
+	case NotNullViolationError:
+		return column + " is required"
+	default:
+		return column + " is invalid"
+	}
+}
@@ -0,0 +1,86 @@
+package nsql_test
+
+import (
+	"errors"
+	"fmt"
+	"github.com/lib/pq"
+	"github.com/nbs-go/nsql"
+	"github.com/nbs-go/nsql/schema"
+	"github.com/nbs-go/nsql/test_utils"
+	"testing"
+)
+
+var userSchema = schema.New(schema.TableName("User"), schema.Columns("id", "email", "companyId", "name"))
+
+func TestParseError(t *testing.T) {
+	// Test #1
+	code, meta := nsql.ParseError(&pq.Error{Code: "23505", Constraint: "idx_user_email"})
+	test_utils.CompareBoolean(t, "PQ UNIQUE ERROR", code == nsql.UniqueError, true)
+	test_utils.CompareString(t, "PQ CONSTRAINT", meta.Constraint, "idx_user_email")
+
+	// Test #2
+	code, meta = nsql.ParseError(errors.New("Error 1062 (23000): Duplicate entry 'a@b.c' for key 'User.idx_user_email'"))
+	test_utils.CompareBoolean(t, "MYSQL UNIQUE ERROR", code == nsql.UniqueError, true)
+	test_utils.CompareString(t, "MYSQL CONSTRAINT", meta.Constraint, "idx_user_email")
+
+	// Test #3
+	code, meta = nsql.ParseError(errors.New("unknown"))
+	test_utils.CompareBoolean(t, "UNKNOWN ERROR", code == nsql.UnknownError && meta == nil, true)
+}
+
+func TestParseError_MySQLCodes(t *testing.T) {
+	testCases := map[string]nsql.ErrorCode{
+		"Error 1000 (HY000): error":                           nsql.UnhandledError,
+		"Error 1213 (40001): Deadlock found":                  nsql.DeadlockError,
+		"Error 1048 (23000): Column 'name' cannot be null":    nsql.NotNullViolationError,
+		"Error 3819 (HY000): Check constraint 'c' violated":   nsql.CheckViolationError,
+		"Error 1205 (HY000): Lock wait timeout exceeded":      nsql.LockTimeoutError,
+		"Error 1317 (70100): Query execution was interrupted": nsql.QueryCanceledError,
+		"Error 1146 (42S02): Table 'test.User' doesn't exist": nsql.UndefinedTableError,
+		"Error 1054 (42S22): Unknown column 'phone'":          nsql.UndefinedColumnError,
+		"Error 1142 (42000): SELECT command denied":           nsql.InsufficientPrivilegeError,
+		"Error 1040 (08004): Too many connections":            nsql.ConnectionError,
+	}
+
+	for message, expected := range testCases {
+		code, _ := nsql.ParseError(errors.New(message))
+		test_utils.CompareBoolean(t, message, code == expected, true)
+	}
+}
+
+func TestConstraintRegistry(t *testing.T) {
+	r := nsql.NewConstraintRegistry().
+		Register(userSchema, "idx_user_email", "email", "").
+		Register(userSchema, "fk_user_company", "companyId", "company does not exist")
+
+	// Test #1
+	fErr, ok := r.Resolve(fmt.Errorf("insert user: %w", &pq.Error{Code: "23505", Constraint: "idx_user_email"}))
+	test_utils.CompareBoolean(t, "RESOLVE UNIQUE", ok, true)
+	test_utils.CompareString(t, "UNIQUE COLUMN", fErr.Column, "email")
+	test_utils.CompareString(t, "UNIQUE MESSAGE", fErr.Error(), "email already exists")
+
+	// Test #2
+	fErr, ok = r.Resolve(errors.New("Error 1452 (23000): Cannot add or update a child row: a foreign key constraint " +
+		"fails (`test`.`User`, CONSTRAINT `fk_user_company` FOREIGN KEY (`companyId`) REFERENCES `Company` (`id`))"))
+	test_utils.CompareBoolean(t, "RESOLVE FK", ok, true)
+	test_utils.CompareString(t, "FK MESSAGE", fErr.Message, "company does not exist")
+
+	// Test #3
+	fErr, ok = r.Resolve(&pq.Error{Code: "23502", Column: "name"})
+	test_utils.CompareBoolean(t, "RESOLVE NOT NULL", ok, true)
+	test_utils.CompareString(t, "NOT NULL MESSAGE", fErr.Message, "name is required")
+
+	// Test #4
+	_, ok = r.Resolve(&pq.Error{Code: "23505", Constraint: "idx_unknown"})
+	test_utils.CompareBoolean(t, "UNREGISTERED CONSTRAINT", ok, false)
+
+	// Test #5
+	var pErr *pq.Error
+	fErr, _ = r.Resolve(&pq.Error{Code: "23505", Constraint: "idx_user_email"})
+	test_utils.CompareBoolean(t, "UNWRAP", errors.As(fErr, &pErr), true)
+}
+
+func TestConstraintRegistry_UnknownColumn(t *testing.T) {
+	defer test_utils.RecoverPanic(t, "REGISTER UNKNOWN COLUMN", `nsql: column "phone" is not declared in table "User"`)()
+	nsql.NewConstraintRegistry().Register(userSchema, "idx_user_phone", "phone", "")
+}
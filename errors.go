@@ -0,0 +1,69 @@
+package nsql
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Errors that are raised by query builder. Use errors.Is to check kind of BuildError
+var (
+	ErrNoColumns       = errors.New("nsql: no columns defined")
+	ErrUnknownTable    = errors.New("nsql: table is not declared")
+	ErrUnknownColumn   = errors.New("nsql: column is not declared")
+	ErrNoArguments     = errors.New("nsql: no arguments")
+	ErrInvalidArgument = errors.New("nsql: invalid argument")
+)
+
+// BuildError is an error raised by query builder on invalid query declaration
+type BuildError struct {
+	Kind    error
+	Message string
+}
+
+func (e *BuildError) Error() string {
+	return e.Message
+}
+
+func (e *BuildError) Unwrap() error {
+	return e.Kind
+}
+
+// NewBuildError create a BuildError with kind and formatted message
+func NewBuildError(kind error, format string, args ...interface{}) *BuildError {
+	return &BuildError{
+		Kind:    kind,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+// RecoverBuildError recover panic that is raised by query builder and set it to err. Panic that is not caused by
+// BuildError will be re-panicked. Use it to guard writer constructors that validate input on declaring query
+//
+//	func findQuery(col string) (q string, err error) {
+//		defer nsql.RecoverBuildError(&err)
+//		return query.Select(query.Count(col, option.Schema(s))).From(s).Build(), nil
+//	}
+func RecoverBuildError(err *error) {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	e, ok := r.(error)
+	var bErr *BuildError
+	if !ok || !errors.As(e, &bErr) {
+		panic(r)
+	}
+	*err = e
+}
+
+// DroppedError is returned by query builder in strict mode when parts of query are dropped, because it refers to a
+// column or table that is not declared
+type DroppedError struct {
+	Parts []string
+}
+
+func (e *DroppedError) Error() string {
+	return fmt.Sprintf("nsql: %d query part(s) dropped in strict mode: %s", len(e.Parts), strings.Join(e.Parts, "; "))
+}
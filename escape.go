@@ -0,0 +1,65 @@
+package nsql
+
+import (
+	"strings"
+)
+
+// maxIdentLength is the longest identifier that is accepted by ValidateIdent. It follows PostgreSQL limit, which is the
+// shortest among supported databases
+const maxIdentLength = 63
+
+// QuoteIdent returns name that is enclosed by open and close quote characters. Close quote character in name is escaped
+// by doubling it, so name can not break out from quoted identifier. It panics with ErrInvalidArgument if name contains
+// NUL character
+func QuoteIdent(name string, open, close byte) string {
+	checkNul("identifier", name)
+
+	var b strings.Builder
+	b.Grow(len(name) + 2)
+	b.WriteByte(open)
+	for i := 0; i < len(name); i++ {
+		if name[i] == close {
+			b.WriteByte(close)
+		}
+		b.WriteByte(name[i])
+	}
+	b.WriteByte(close)
+	return b.String()
+}
+
+// QuoteLiteral returns s as string literal that is enclosed by single quotes. Single quote in s is escaped by doubling
+// it. It panics with ErrInvalidArgument if s contains NUL character
+func QuoteLiteral(s string) string {
+	checkNul("string literal", s)
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+// IsSafeIdent returns true if name is matched with identifier allow-list. A safe identifier starts with a letter or
+// underscore, followed by letters, digits or underscores, and is not longer than 63 characters
+func IsSafeIdent(name string) bool {
+	if name == "" || len(name) > maxIdentLength || !isNameStart(name[0]) {
+		return false
+	}
+	for i := 1; i < len(name); i++ {
+		if c := name[i]; !isNameStart(c) && (c < '0' || c > '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidateIdent returns BuildError with ErrInvalidArgument kind if name is not a safe identifier. Use it to validate
+// identifiers that are supplied by user, such as alias or sort column, before passing them to query builder
+func ValidateIdent(name string) error {
+	if !IsSafeIdent(name) {
+		return NewBuildError(ErrInvalidArgument, `nsql: invalid identifier "%s"`, name)
+	}
+	return nil
+}
+
+// checkNul panic if s contains NUL character, since it terminates query in some database drivers
+func checkNul(kind, s string) {
+	if strings.IndexByte(s, 0) >= 0 {
+		panic(NewBuildError(ErrInvalidArgument, "nsql: %s must not contain NUL character", kind))
+	}
+}
@@ -0,0 +1,45 @@
+package nsql_test
+
+import (
+	"errors"
+	"github.com/nbs-go/nsql"
+	"github.com/nbs-go/nsql/test_utils"
+	"testing"
+)
+
+func TestQuoteIdent(t *testing.T) {
+	test_utils.CompareString(t, "PLAIN", nsql.QuoteIdent("fullName", '"', '"'), `"fullName"`)
+	test_utils.CompareString(t, "DOUBLE QUOTE", nsql.QuoteIdent(`a" FROM "b`, '"', '"'), `"a"" FROM ""b"`)
+	test_utils.CompareString(t, "BACKTICK", nsql.QuoteIdent("a`b", '`', '`'), "`a``b`")
+	test_utils.CompareString(t, "BRACKET", nsql.QuoteIdent("a]b[c", '[', ']'), "[a]]b[c]")
+}
+
+func TestQuoteIdent_Nul(t *testing.T) {
+	defer test_utils.RecoverPanic(t, "NUL IDENTIFIER", "nsql: identifier must not contain NUL character")()
+	nsql.QuoteIdent("a\x00b", '"', '"')
+}
+
+func TestQuoteLiteral(t *testing.T) {
+	test_utils.CompareString(t, "PLAIN", nsql.QuoteLiteral("title"), `'title'`)
+	test_utils.CompareString(t, "SINGLE QUOTE", nsql.QuoteLiteral(`a' OR '1'='1`), `'a'' OR ''1''=''1'`)
+}
+
+func TestQuoteLiteral_Nul(t *testing.T) {
+	defer test_utils.RecoverPanic(t, "NUL LITERAL", "nsql: string literal must not contain NUL character")()
+	nsql.QuoteLiteral("a\x00")
+}
+
+func TestValidateIdent(t *testing.T) {
+	for _, name := range []string{"id", "_fullName", "person2"} {
+		if err := nsql.ValidateIdent(name); err != nil {
+			t.Errorf("%s: FAILED\n  > unexpected error. Error = %s", name, err)
+		}
+	}
+
+	for _, name := range []string{"", "2name", "full name", `a"b`, "a.b", "a;b", "ä",
+		"a123456789012345678901234567890123456789012345678901234567890123"} {
+		if err := nsql.ValidateIdent(name); !errors.Is(err, nsql.ErrInvalidArgument) {
+			t.Errorf("%s: FAILED\n  > expected invalid argument error. Error = %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package query
+
+import (
+	"github.com/nbs-go/nsql"
+	"github.com/nbs-go/nsql/op"
+	"strings"
+)
+
+// Dialect implements nsql.Dialect for SQL Server. Primary key of inserted row is returned with OUTPUT INSERTED clause,
+// and INSERT query with OnConflict is written as MERGE statement
+type Dialect struct {
+	nsql.StandardDialect
+}
+
+func (Dialect) Name() string {
+	return "SQL Server"
+}
+
+func (Dialect) QuoteIdent(name string) string {
+	return nsql.QuoteIdent(name, '[', ']')
+}
+
+func (Dialect) BindType() op.VariableFormat {
+	return op.AtPVar
+}
+
+// Operator write ILIKE as LIKE, since LIKE in SQL Server is case-insensitive on default collation
+func (d Dialect) Operator(o op.Operator) string {
+	switch o {
+	case op.ILike:
+		return "LIKE"
+	case op.NotILike:
+		return "NOT LIKE"
+	}
+	return d.StandardDialect.Operator(o)
+}
+
+// WriteLimitQuery write OFFSET and FETCH NEXT clause. Offset is set to 0 if only limit is set
+func (Dialect) WriteLimitQuery(sb *strings.Builder, limit *int64, offset *int64) {
+	if limit == nil && offset == nil {
+		return
+	}
+
+	var n int64
+	if offset != nil {
+		n = *offset
+	}
+	sb.WriteString(" OFFSET ")
+	nsql.WriteInt(sb, n)
+	sb.WriteString(" ROWS")
+	if limit != nil {
+		sb.WriteString(" FETCH NEXT ")
+		nsql.WriteInt(sb, *limit)
+		sb.WriteString(" ROWS ONLY")
+	}
+}
+
+// WritePaginationPrefix write TOP clause if only limit is set
+func (Dialect) WritePaginationPrefix(sb *strings.Builder, limit *int64, offset *int64) {
+	if offset != nil || limit == nil {
+		return
+	}
+	sb.WriteString("TOP ")
+	nsql.WriteInt(sb, *limit)
+	sb.WriteByte(' ')
+}
+
+// WritePaginationSuffix write OFFSET and FETCH NEXT clause if offset is set, that requires ORDER BY
+func (d Dialect) WritePaginationSuffix(sb *strings.Builder, limit *int64, offset *int64, ordered bool) error {
+	if offset == nil {
+		return nil
+	}
+
+	if !ordered {
+		return nsql.NewBuildError(nsql.ErrInvalidArgument, "nsql: OFFSET clause requires ORDER BY in %s", d.Name())
+	}
+	d.WriteLimitQuery(sb, limit, offset)
+	return nil
+}
+
+// BoolLiteral write boolean value as bit value, since SQL Server does not have TRUE and FALSE literals
+func (Dialect) BoolLiteral(v bool) string {
+	if v {
+		return "1"
+	}
+	return "0"
+}
+
+// SelectPredicate write predicate as CASE expression, since SQL Server can not select boolean expression
+func (Dialect) SelectPredicate(predicate string) string {
+	return "CASE WHEN " + predicate + " THEN 1 ELSE 0 END"
+}
+
+func (Dialect) Supports(f nsql.Feature) bool {
+	switch f {
+	case nsql.FeatureOutputInserted, nsql.FeatureMerge, nsql.FeaturePositionalVar:
+		return true
+	}
+	return false
+}
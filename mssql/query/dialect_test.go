@@ -0,0 +1,122 @@
+package query_test
+
+import (
+	"errors"
+	"github.com/nbs-go/nsql"
+	"github.com/nbs-go/nsql/mssql/query"
+	"github.com/nbs-go/nsql/op"
+	"github.com/nbs-go/nsql/option"
+	"github.com/nbs-go/nsql/schema"
+	"github.com/nbs-go/nsql/test_utils"
+	"testing"
+	"time"
+)
+
+type Person struct {
+	CreatedAt time.Time `db:"createdAt"`
+	UpdatedAt time.Time `db:"updatedAt"`
+	Id        int64     `db:"id"`
+	Email     string    `db:"email"`
+	FullName  string    `db:"fullName"`
+}
+
+var person = schema.New(schema.FromModelRef(Person{}))
+
+func TestDialect_Select(t *testing.T) {
+	actual := query.Select(query.Columns("id", "fullName")).
+		From(person).
+		Where(query.Equal(query.Column("email")), query.Like(query.Column("fullName"))).
+		Build(option.VariableFormat(op.AtPVar))
+	test_utils.CompareString(t, "SELECT", actual,
+		"SELECT [Person].[id], [Person].[fullName] FROM [Person] WHERE [Person].[email] = @p1 AND [Person].[fullName] LIKE @p2")
+}
+
+func TestDialect_SelectTop(t *testing.T) {
+	actual := query.Select(query.Column("id")).From(person).Limit(10).Build()
+	test_utils.CompareString(t, "SELECT TOP", actual, "SELECT TOP 10 [Person].[id] FROM [Person]")
+}
+
+func TestDialect_SelectOffsetFetch(t *testing.T) {
+	actual := query.Select(query.Column("id")).
+		From(person).
+		OrderBy("createdAt", option.SortDirection(op.Descending)).
+		Limit(10).
+		Skip(20).
+		Build()
+	test_utils.CompareString(t, "OFFSET FETCH NEXT", actual,
+		"SELECT [Person].[id] FROM [Person] ORDER BY [Person].[createdAt] DESC OFFSET 20 ROWS FETCH NEXT 10 ROWS ONLY")
+}
+
+func TestDialect_SelectOffsetWithoutOrderBy(t *testing.T) {
+	_, err := query.Select(query.Column("id")).From(person).Limit(10).Skip(20).BuildE()
+	if !errors.Is(err, nsql.ErrInvalidArgument) {
+		t.Errorf("OFFSET WITHOUT ORDER BY: FAILED\n  > expected invalid argument error. Error = %v", err)
+	}
+}
+
+func TestDialect_SelectDollarVarNotSupported(t *testing.T) {
+	defer test_utils.RecoverPanic(t, "DOLLAR VAR NOT SUPPORTED", "op.DollarVar variable format is not supported by SQL Server")()
+	query.Select(query.Column("*")).From(person).Build(option.VariableFormat(op.DollarVar))
+}
+
+func TestDialect_InsertOutput(t *testing.T) {
+	test_utils.CompareString(t, "INSERT OUTPUT INSERTED",
+		query.Insert(person, "email", "fullName").Build(option.VariableFormat(op.AtPVar)),
+		"INSERT INTO [Person]([email], [fullName]) OUTPUT INSERTED.[id] VALUES (@p1, @p2)")
+}
+
+func TestDialect_Merge(t *testing.T) {
+	test_utils.CompareString(t, "MERGE UPDATE",
+		query.Insert(person, "email", "fullName").OnConflict("email").DoUpdate().Build(option.VariableFormat(op.NamedVar)),
+		"MERGE INTO [Person] WITH (HOLDLOCK) AS [t] USING (VALUES (:email, :fullName)) AS [s]([email], [fullName]) "+
+			"ON [t].[email] = [s].[email] WHEN MATCHED THEN UPDATE SET [t].[fullName] = [s].[fullName] "+
+			"WHEN NOT MATCHED THEN INSERT ([email], [fullName]) VALUES ([s].[email], [s].[fullName]) OUTPUT INSERTED.[id];")
+
+	test_utils.CompareString(t, "MERGE DO NOTHING",
+		query.Insert(person, "email", "fullName").OnConflict("email").Build(option.VariableFormat(op.AtPVar)),
+		"MERGE INTO [Person] WITH (HOLDLOCK) AS [t] USING (VALUES (@p1, @p2)) AS [s]([email], [fullName]) "+
+			"ON [t].[email] = [s].[email] "+
+			"WHEN NOT MATCHED THEN INSERT ([email], [fullName]) VALUES ([s].[email], [s].[fullName]) OUTPUT INSERTED.[id];")
+}
+
+func TestDialect_UpdateDelete(t *testing.T) {
+	test_utils.CompareString(t, "UPDATE",
+		query.Update(person, "fullName").Build(option.VariableFormat(op.AtPVar)),
+		"UPDATE [Person] SET [fullName] = @p1 WHERE [id] = @p2")
+
+	test_utils.CompareString(t, "DELETE",
+		query.Delete(person).Build(option.VariableFormat(op.AtPVar)),
+		"DELETE FROM [Person] WHERE [id] = @p1")
+}
+
+func TestDialect_DefaultAtPVar(t *testing.T) {
+	test_utils.CompareString(t, "SELECT",
+		query.Select(query.Column("id")).From(person).Where(query.Equal(query.Column("email"))).Build(),
+		"SELECT [Person].[id] FROM [Person] WHERE [Person].[email] = @p1")
+	test_utils.CompareString(t, "INSERT",
+		query.Insert(person, "email", "fullName").Build(),
+		"INSERT INTO [Person]([email], [fullName]) OUTPUT INSERTED.[id] VALUES (@p1, @p2)")
+	test_utils.CompareString(t, "UPDATE",
+		query.Update(person, "fullName").Build(),
+		"UPDATE [Person] SET [fullName] = @p1 WHERE [id] = @p2")
+	test_utils.CompareString(t, "DELETE",
+		query.Delete(person).Build(),
+		"DELETE FROM [Person] WHERE [id] = @p1")
+
+	actual, args := query.Update(person, "fullName").Values("john").BuildWithArgs(query.Value(1))
+	test_utils.CompareString(t, "UPDATE WITH ARGS", actual, "UPDATE [Person] SET [fullName] = @p1 WHERE [id] = @p2")
+	test_utils.CompareInterfaceArray(t, "UPDATE ARGS", args, []interface{}{"john", 1})
+}
+
+func TestDialect_IsExists(t *testing.T) {
+	actual := query.Schema(person).IsExists(query.Equal(query.Column("email")), option.VariableFormat(op.AtPVar))
+	test_utils.CompareString(t, "IS EXISTS", actual,
+		"SELECT CASE WHEN COUNT([Person].[id]) > 0 THEN 1 ELSE 0 END AS [isExists] FROM [Person] WHERE [Person].[email] = @p1")
+}
+
+func TestDialect_BoolLiteral(t *testing.T) {
+	actual := query.Select(query.Column("id")).From(person).
+		Where(query.Equal(query.Column("fullName"), query.BoolVar(true))).
+		Build()
+	test_utils.CompareString(t, "BOOL LITERAL", actual, "SELECT [Person].[id] FROM [Person] WHERE [Person].[fullName] = 1")
+}
@@ -0,0 +1,23 @@
+package mysqlErr
+
+type Code = int8
+
+// Code constants are mapped to nsql.ErrorCode, so errors from both drivers can be handled in the same way. MySQL reports
+// serialization failure as deadlock, so SerializationFailureError is never returned by Parse
+const (
+	UnknownError = Code(iota)
+	UnhandledError
+	UniqueError
+	FkViolationError
+	SerializationFailureError
+	DeadlockError
+	NotNullViolationError
+	CheckViolationError
+	ExclusionViolationError
+	LockTimeoutError
+	QueryCanceledError
+	UndefinedTableError
+	UndefinedColumnError
+	InsufficientPrivilegeError
+	ConnectionError
+)
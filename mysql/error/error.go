@@ -0,0 +1,171 @@
+package mysqlErr
+
+import (
+	"database/sql/driver"
+	"errors"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Parse classify MySQL error. Error is resolved from *mysql.MySQLError of github.com/go-sql-driver/mysql, or from
+// error with message in format of "Error 1062 (23000): Duplicate entry...", so this package does not depend on driver.
+// Connection failures are returned by driver as driver.ErrBadConn or mysql.ErrInvalidConn, and classified as
+// ConnectionError
+func Parse(err error) (Code, *Metadata) {
+	if isConnectionError(err) {
+		return ConnectionError, &Metadata{Message: err.Error()}
+	}
+
+	meta, ok := parseMetadata(err)
+	if !ok {
+		return UnknownError, nil
+	}
+
+	switch meta.Number {
+	case 1062, 1586:
+		meta.Table, meta.Constraint = splitQualifiedName(lastQuoted(meta.Message, "key '"))
+		return UniqueError, meta
+	case 1451, 1452, 1216, 1217:
+		setForeignKeyMetadata(meta)
+		return FkViolationError, meta
+	case 1213:
+		return DeadlockError, meta
+	case 1048:
+		meta.Column = lastQuoted(meta.Message, "Column '")
+		return NotNullViolationError, meta
+	case 3819:
+		meta.Constraint = lastQuoted(meta.Message, "constraint '")
+		return CheckViolationError, meta
+	case 1205:
+		return LockTimeoutError, meta
+	case 1317, 3024:
+		return QueryCanceledError, meta
+	case 1146:
+		_, meta.Table = splitQualifiedName(lastQuoted(meta.Message, "Table '"))
+		return UndefinedTableError, meta
+	case 1054:
+		meta.Column = lastQuoted(meta.Message, "column '")
+		return UndefinedColumnError, meta
+	case 1044, 1045, 1142, 1143, 1227:
+		return InsufficientPrivilegeError, meta
+	case 1040, 1053, 1152, 1153, 1158, 1159, 1160, 1161:
+		return ConnectionError, meta
+	default:
+		return UnhandledError, meta
+	}
+}
+
+// invalidConnMessage is message of mysql.ErrInvalidConn in github.com/go-sql-driver/mysql
+const invalidConnMessage = "invalid connection"
+
+// isConnectionError returns true if error is a connection failure that is returned by driver
+func isConnectionError(err error) bool {
+	if errors.Is(err, driver.ErrBadConn) {
+		return true
+	}
+	for ; err != nil; err = errors.Unwrap(err) {
+		if err.Error() == invalidConnMessage {
+			return true
+		}
+	}
+	return false
+}
+
+// messagePattern matches error message, e.g. "Error 1062 (23000): Duplicate entry" or "Error 1062: Duplicate entry"
+var messagePattern = regexp.MustCompile(`^Error (\d+)(?: \(([0-9A-Z]{5})\))?: (.*)$`)
+
+var uint16Type = reflect.TypeOf(uint16(0))
+
+func parseMetadata(err error) (*Metadata, bool) {
+	for ; err != nil; err = errors.Unwrap(err) {
+		// Get from fields of MySQLError
+		if meta, ok := fieldsMetadata(err); ok {
+			return meta, true
+		}
+
+		// Get from error message
+		m := messagePattern.FindStringSubmatch(err.Error())
+		if m == nil {
+			continue
+		}
+
+		n, _ := strconv.Atoi(m[1])
+		return &Metadata{
+			Number:   n,
+			SQLState: m[2],
+			Message:  m[3],
+		}, true
+	}
+	return nil, false
+}
+
+// fieldsMetadata get metadata from struct that has Number, SQLState and Message fields as in MySQLError
+func fieldsMetadata(err error) (*Metadata, bool) {
+	rv := reflect.ValueOf(err)
+	if rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, false
+	}
+
+	number := rv.FieldByName("Number")
+	message := rv.FieldByName("Message")
+	if !number.IsValid() || number.Type() != uint16Type || !message.IsValid() || message.Kind() != reflect.String {
+		return nil, false
+	}
+
+	meta := Metadata{
+		Number:  int(number.Uint()),
+		Message: message.String(),
+	}
+
+	// Get SQLState that is declared as [5]byte
+	if s := rv.FieldByName("SQLState"); s.IsValid() && s.Kind() == reflect.Array && s.Type().Elem().Kind() == reflect.Uint8 {
+		b := make([]byte, s.Len())
+		for i := range b {
+			b[i] = byte(s.Index(i).Uint())
+		}
+		meta.SQLState = strings.TrimRight(string(b), "\x00")
+	}
+
+	return &meta, true
+}
+
+// fkPattern matches foreign key constraint in message, e.g. (`db`.`child`, CONSTRAINT `fk_name` FOREIGN KEY (`col`)
+var fkPattern = regexp.MustCompile("`([^`]+)`, CONSTRAINT `([^`]+)` FOREIGN KEY \\(`([^`]+)`")
+
+func setForeignKeyMetadata(meta *Metadata) {
+	m := fkPattern.FindStringSubmatch(meta.Message)
+	if m == nil {
+		return
+	}
+	meta.Table = m[1]
+	meta.Constraint = m[2]
+	meta.Column = m[3]
+}
+
+// lastQuoted returns single-quoted text that is started after last prefix in message
+func lastQuoted(message, prefix string) string {
+	i := strings.LastIndex(message, prefix)
+	if i < 0 {
+		return ""
+	}
+
+	s := message[i+len(prefix):]
+	end := strings.IndexByte(s, '\'')
+	if end < 0 {
+		return ""
+	}
+	return s[:end]
+}
+
+// splitQualifiedName split name in format of "table.name". If name is not qualified, then table is empty
+func splitQualifiedName(s string) (string, string) {
+	if i := strings.LastIndexByte(s, '.'); i >= 0 {
+		return s[:i], s[i+1:]
+	}
+	return "", s
+}
@@ -0,0 +1,140 @@
+package mysqlErr_test
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	mysqlErr "github.com/nbs-go/nsql/mysql/error"
+	"testing"
+)
+
+// MySQLError has the same fields as MySQLError in github.com/go-sql-driver/mysql
+type MySQLError struct {
+	Number   uint16
+	SQLState [5]byte
+	Message  string
+}
+
+func (me *MySQLError) Error() string {
+	return fmt.Sprintf("Error %d (%s): %s", me.Number, me.SQLState, me.Message)
+}
+
+func newError(number uint16, state string, message string) *MySQLError {
+	e := &MySQLError{Number: number, Message: message}
+	copy(e.SQLState[:], state)
+	return e
+}
+
+func TestUnknownError(t *testing.T) {
+	// Prepare test case
+	sErr := errors.New("not a mysql error")
+	expected := mysqlErr.UnknownError
+	// Do test
+	actual, _ := mysqlErr.Parse(sErr)
+	if actual != expected {
+		t.Errorf("unexpected test result. Expected = %d, Actual = %d", expected, actual)
+	}
+}
+
+func TestUniqueError(t *testing.T) {
+	// Prepare test case
+	sErr := newError(1062, "23000", "Duplicate entry 'john@example.com' for key 'Customer.idx_Customer_email'")
+	expected := mysqlErr.UniqueError
+	// Do test
+	actual, meta := mysqlErr.Parse(sErr)
+	if actual != expected {
+		t.Errorf("unexpected test result. Expected = %d, Actual = %d", expected, actual)
+	}
+
+	// Do test on metadata
+	if meta.Constraint != "idx_Customer_email" {
+		t.Errorf("unexpected test result. Expected Constraint = idx_Customer_email, Actual = %s", meta.Constraint)
+	}
+	if meta.Table != "Customer" {
+		t.Errorf("unexpected test result. Expected Table = Customer, Actual = %s", meta.Table)
+	}
+	if meta.SQLState != "23000" {
+		t.Errorf("unexpected test result. Expected SQLState = 23000, Actual = %s", meta.SQLState)
+	}
+}
+
+func TestFkViolationError(t *testing.T) {
+	// Prepare test case
+	sErr := newError(1452, "23000", "Cannot add or update a child row: a foreign key constraint fails "+
+		"(`test`.`Vehicle`, CONSTRAINT `fk_Vehicle_Company` FOREIGN KEY (`companyId`) REFERENCES `Company` (`id`))")
+	expected := mysqlErr.FkViolationError
+	// Do test
+	actual, meta := mysqlErr.Parse(sErr)
+	if actual != expected {
+		t.Errorf("unexpected test result. Expected = %d, Actual = %d", expected, actual)
+	}
+
+	// Do test on metadata
+	if meta.Constraint != "fk_Vehicle_Company" {
+		t.Errorf("unexpected test result. Expected Constraint = fk_Vehicle_Company, Actual = %s", meta.Constraint)
+	}
+	if meta.Table != "Vehicle" || meta.Column != "companyId" {
+		t.Errorf("unexpected test result. Expected Table = Vehicle, Column = companyId, Actual = %s, %s",
+			meta.Table, meta.Column)
+	}
+}
+
+func TestErrorMessage(t *testing.T) {
+	// Prepare test case
+	sErr := fmt.Errorf("insert failed: %w", errors.New("Error 1048 (23000): Column 'name' cannot be null"))
+	expected := mysqlErr.NotNullViolationError
+	// Do test
+	actual, meta := mysqlErr.Parse(sErr)
+	if actual != expected {
+		t.Errorf("unexpected test result. Expected = %d, Actual = %d", expected, actual)
+	}
+
+	// Do test on metadata
+	if meta.Column != "name" {
+		t.Errorf("unexpected test result. Expected Column = name, Actual = %s", meta.Column)
+	}
+	if meta.Number != 1048 {
+		t.Errorf("unexpected test result. Expected Number = 1048, Actual = %d", meta.Number)
+	}
+}
+
+func TestErrorNumbers(t *testing.T) {
+	// Prepare test case
+	testCases := map[uint16]mysqlErr.Code{
+		1451: mysqlErr.FkViolationError,
+		1213: mysqlErr.DeadlockError,
+		3819: mysqlErr.CheckViolationError,
+		1205: mysqlErr.LockTimeoutError,
+		1317: mysqlErr.QueryCanceledError,
+		1146: mysqlErr.UndefinedTableError,
+		1054: mysqlErr.UndefinedColumnError,
+		1142: mysqlErr.InsufficientPrivilegeError,
+		1040: mysqlErr.ConnectionError,
+		1000: mysqlErr.UnhandledError,
+	}
+
+	for number, expected := range testCases {
+		// Do test
+		actual, _ := mysqlErr.Parse(newError(number, "HY000", "error"))
+		if actual != expected {
+			t.Errorf("unexpected test result. Number = %d, Expected = %d, Actual = %d", number, expected, actual)
+		}
+	}
+}
+
+func TestConnectionError(t *testing.T) {
+	// Prepare test case, errors that are returned by driver on connection failure
+	testCases := []error{
+		driver.ErrBadConn,
+		fmt.Errorf("query: %w", driver.ErrBadConn),
+		errors.New("invalid connection"),
+	}
+
+	for _, err := range testCases {
+		// Do test
+		actual, meta := mysqlErr.Parse(err)
+		if actual != mysqlErr.ConnectionError || meta == nil {
+			t.Errorf("unexpected test result. Error = %s, Expected = %d, Actual = %d", err, mysqlErr.ConnectionError, actual)
+		}
+	}
+}
@@ -0,0 +1,12 @@
+package mysqlErr
+
+type Metadata struct {
+	Constraint string
+	Message    string
+	Table      string
+	Column     string
+	// Number is raw error number that is returned by MySQL, e.g. 1062
+	Number int
+	// SQLState is SQLSTATE that is returned by MySQL. It is empty if error message does not contain SQLSTATE
+	SQLState string
+}
@@ -0,0 +1,114 @@
+package query_test
+
+import (
+	"github.com/nbs-go/nsql"
+	"github.com/nbs-go/nsql/mysql/query"
+	"github.com/nbs-go/nsql/op"
+	"github.com/nbs-go/nsql/option"
+	"github.com/nbs-go/nsql/schema"
+	"github.com/nbs-go/nsql/test_utils"
+	"testing"
+)
+
+func TestBuildWithArgs_Select(t *testing.T) {
+	pSchema := schema.New(schema.FromModelRef(Person{}), schema.As("p"))
+	voSchema := schema.New(schema.FromModelRef(VehicleOwnership{}), schema.As("vo"))
+
+	q, args := query.Select(query.Column("id"), query.Column("vehicleId", option.Schema(voSchema))).
+		From(pSchema).
+		Join(voSchema, query.And(
+			query.Equal(query.Column("id"), query.On("personId")),
+			query.NotEqual(query.Column("vehicleId", option.Schema(voSchema)), query.Value(3)),
+		)).
+		Where(
+			query.Between(query.Column("createdAt"), query.Values("2022-01-01", "2022-12-31")),
+			query.Or(
+				query.In(query.Column("id"), 2, query.Values(1, 2)),
+				query.Like(query.Column("fullName"), query.Value("john%")),
+			),
+			query.IsNotNull(query.Column("updatedAt")),
+		).
+		BuildWithArgs()
+
+	test_utils.CompareString(t, "SELECT QUERY", q,
+		"SELECT `p`.`id` AS `p.id`, `vo`.`vehicleId` AS `vo.vehicleId` FROM `Person` AS `p` INNER JOIN `VehicleOwnership` AS `vo` ON `p`.`id` = `vo`.`personId` AND `vo`.`vehicleId` != ? WHERE `p`.`createdAt` BETWEEN ? AND ? AND (`p`.`id` IN (?, ?) OR `p`.`fullName` LIKE ?) AND `p`.`updatedAt` IS NOT NULL")
+	test_utils.CompareInterfaceArray(t, "SELECT ARGS", args, []interface{}{3, "2022-01-01", "2022-12-31", 1, 2, "john%"})
+}
+
+func TestBuildWithArgs_SelectDroppedCondition(t *testing.T) {
+	q, args := query.Select(query.Column("id")).
+		From(person).
+		Where(
+			query.Equal(query.Column("gender"), query.Value("m")),
+			query.Equal(query.Column("fullName"), query.Value("john")),
+		).
+		BuildWithArgs()
+
+	test_utils.CompareString(t, "DROPPED CONDITION QUERY", q, "SELECT `Person`.`id` FROM `Person` WHERE `Person`.`fullName` = ?")
+	test_utils.CompareInterfaceArray(t, "DROPPED CONDITION ARGS", args, []interface{}{"john"})
+}
+
+func TestBuildWithArgs_Filter(t *testing.T) {
+	filters := query.NewFilter(map[string]string{
+		"fullName": "john",
+		"id":       "1",
+	}, map[string]nsql.FilterParser{
+		"fullName": query.LikeFilter("fullName", op.LikeSubString, option.Schema(person)),
+		"id":       query.EqualFilter(person, "id"),
+	})
+
+	q, args := query.Select(query.Column("id")).From(person).Where(filters.Conditions()).BuildWithArgs()
+	test_utils.CompareStringIn(t, "FILTER QUERY", q, []string{
+		"SELECT `Person`.`id` FROM `Person` WHERE `Person`.`fullName` LIKE ? AND `Person`.`id` = ?",
+		"SELECT `Person`.`id` FROM `Person` WHERE `Person`.`id` = ? AND `Person`.`fullName` LIKE ?",
+	})
+	test_utils.CompareInterfaceArray(t, "FILTER ARGS", args, filters.Args())
+}
+
+func TestBuildWithArgs_Insert(t *testing.T) {
+	q, args := query.Insert(person, "fullName", "gender", "createdAt").
+		Values("john", "m", "2022-01-01").
+		BuildWithArgs()
+
+	test_utils.CompareString(t, "INSERT QUERY", q,
+		"INSERT INTO `Person`(`fullName`, `createdAt`) VALUES (?, ?)")
+	test_utils.CompareInterfaceArray(t, "INSERT ARGS", args, []interface{}{"john", "2022-01-01"})
+}
+
+func TestBuildWithArgs_Update(t *testing.T) {
+	q, args := query.Update(person, "id", "fullName", "updatedAt").
+		Values(10, "john", "2022-01-01").
+		Where(query.And(
+			query.Equal(query.Column("id"), query.Value(10)),
+			query.In(query.Column("createdAt"), 2, query.Values("2021-01-01", "2021-01-02")),
+		)).
+		BuildWithArgs()
+
+	test_utils.CompareString(t, "UPDATE QUERY", q,
+		"UPDATE `Person` SET `fullName` = ?, `updatedAt` = ? WHERE `id` = ? AND `createdAt` IN (?, ?)")
+	test_utils.CompareInterfaceArray(t, "UPDATE ARGS", args, []interface{}{"john", "2022-01-01", 10, "2021-01-01", "2021-01-02"})
+}
+
+func TestBuildWithArgs_Delete(t *testing.T) {
+	q, args := query.Delete(person).
+		Where(query.In(query.Column("id"), 3, query.Values(1, 2, 3))).
+		BuildWithArgs()
+
+	test_utils.CompareString(t, "DELETE QUERY", q, "DELETE FROM `Person` WHERE `id` IN (?, ?, ?)")
+	test_utils.CompareInterfaceArray(t, "DELETE ARGS", args, []interface{}{1, 2, 3})
+}
+
+func TestBuildWithArgs_NoValue(t *testing.T) {
+	defer test_utils.RecoverPanic(t, "NO VALUE", `bind variable of column "id" has no value`)()
+	query.Select(query.Column("id")).From(person).Where(query.Equal(query.Column("id"))).BuildWithArgs()
+}
+
+func TestBuildWithArgs_ValuesMismatch(t *testing.T) {
+	defer test_utils.RecoverPanic(t, "VALUES MISMATCH", `variable of column "id" expects 2 value(s), got 1`)()
+	query.In(query.Column("id"), 2, query.Values(1))
+}
+
+func TestBuildWithArgs_InsertValuesMismatch(t *testing.T) {
+	defer test_utils.RecoverPanic(t, "INSERT VALUES MISMATCH", `INSERT expects 2 value(s), got 1`)()
+	query.Insert(person, "fullName", "createdAt").Values("john").BuildWithArgs()
+}
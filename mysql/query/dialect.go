@@ -0,0 +1,37 @@
+package query
+
+import (
+	"github.com/nbs-go/nsql"
+	"github.com/nbs-go/nsql/op"
+	"strings"
+)
+
+// Dialect implements nsql.Dialect for MySQL
+type Dialect struct {
+	nsql.StandardDialect
+}
+
+func (Dialect) Name() string {
+	return "MySQL"
+}
+
+func (Dialect) QuoteIdent(name string) string {
+	return nsql.QuoteIdent(name, '`', '`')
+}
+
+// QuoteLiteral escape backslash in addition to single quote, since backslash is an escape character in MySQL string
+// literal unless NO_BACKSLASH_ESCAPES mode is enabled
+func (Dialect) QuoteLiteral(s string) string {
+	return nsql.QuoteLiteral(strings.ReplaceAll(s, `\`, `\\`))
+}
+
+// Operator write ILIKE as LIKE, since LIKE in MySQL is case-insensitive on default collation
+func (d Dialect) Operator(o op.Operator) string {
+	switch o {
+	case op.ILike:
+		return "LIKE"
+	case op.NotILike:
+		return "NOT LIKE"
+	}
+	return d.StandardDialect.Operator(o)
+}
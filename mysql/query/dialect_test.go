@@ -0,0 +1,56 @@
+package query_test
+
+import (
+	"errors"
+	"github.com/nbs-go/nsql"
+	"github.com/nbs-go/nsql/mysql/query"
+	"github.com/nbs-go/nsql/op"
+	"github.com/nbs-go/nsql/option"
+	"github.com/nbs-go/nsql/schema"
+	"github.com/nbs-go/nsql/test_utils"
+	"testing"
+)
+
+func TestDialect_Lower(t *testing.T) {
+	actual := query.Select(query.Lower(query.Column("fullName"), option.As("name"))).
+		From(person).
+		Build()
+	test_utils.CompareString(t, "LOWER WITH ALIAS", actual, "SELECT LOWER(`Person`.`fullName`) AS `name` FROM `Person`")
+}
+
+func TestDialect_LikeFilter(t *testing.T) {
+	w, _ := query.LikeFilter("fullName", op.LikeSubString, option.Schema(person))("john")
+	actual := query.Select(query.Column("id")).From(person).Where(w).Build()
+	test_utils.CompareString(t, "CASE-INSENSITIVE LIKE", actual,
+		"SELECT `Person`.`id` FROM `Person` WHERE `Person`.`fullName` LIKE ?")
+}
+
+func TestDialect_SelectDollarVarNotSupported(t *testing.T) {
+	defer test_utils.RecoverPanic(t, "DOLLAR VAR NOT SUPPORTED", "op.DollarVar variable format is not supported by MySQL")()
+	query.Select(query.Column("*")).From(person).Build(option.VariableFormat(op.DollarVar))
+}
+
+func TestDialect_OnConflictNotSupported(t *testing.T) {
+	defer test_utils.RecoverPanic(t, "ON CONFLICT NOT SUPPORTED", "ON CONFLICT clause is not supported by MySQL")()
+	query.Insert(person, "id", "fullName").OnConflict("id").Build()
+}
+
+func TestDialect_OnConflictNotSupported_BuildE(t *testing.T) {
+	_, err := query.Insert(person, "id", "fullName").OnConflict("id").DoUpdate().BuildE()
+	if !errors.Is(err, nsql.ErrInvalidArgument) {
+		t.Errorf("ON CONFLICT NOT SUPPORTED BUILDE: FAILED\n  > expected invalid argument error. Error = %v", err)
+		return
+	}
+	test_utils.CompareString(t, "ON CONFLICT NOT SUPPORTED BUILDE", err.Error(), "ON CONFLICT clause is not supported by MySQL")
+}
+
+func TestDialect_QuoteLiteral(t *testing.T) {
+	test_utils.CompareString(t, "ESCAPE BACKSLASH", query.Dialect{}.QuoteLiteral(`a\' OR 1=1 --`), `'a\\'' OR 1=1 --'`)
+	test_utils.CompareString(t, "ESCAPE BACKTICK", query.Dialect{}.QuoteIdent("a`b"), "`a``b`")
+}
+
+func TestDialect_Namespace(t *testing.T) {
+	s := schema.New(schema.FromModelRef(Person{}), schema.Namespace("crm"), schema.As("p"))
+	actual := query.Select(query.Column("id")).From(s).Build()
+	test_utils.CompareString(t, "DATABASE-QUALIFIED TABLE", actual, "SELECT `p`.`id` FROM `crm`.`Person` AS `p`")
+}
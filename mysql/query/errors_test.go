@@ -0,0 +1,77 @@
+package query_test
+
+import (
+	"errors"
+	"github.com/nbs-go/nsql"
+	"github.com/nbs-go/nsql/mysql/query"
+	"github.com/nbs-go/nsql/option"
+	"github.com/nbs-go/nsql/schema"
+	"github.com/nbs-go/nsql/test_utils"
+	"testing"
+)
+
+func testBuildError(t *testing.T, expectation string, q string, err error, kind error, expected string) {
+	t.Helper()
+	if err == nil {
+		t.Errorf("%s: FAILED\n  > expected error, got query: %s", expectation, q)
+		return
+	}
+
+	if !errors.Is(err, kind) {
+		t.Errorf("%s: FAILED\n  > expected error kind: %s\n  > actual error: %s", expectation, kind, err)
+	}
+	test_utils.CompareString(t, expectation, err.Error(), expected)
+}
+
+func TestBuildE_InNoArguments(t *testing.T) {
+	q, err := query.From(person).
+		Where(query.In(query.Column("id"), 0)).
+		BuildE()
+	testBuildError(t, "IN NO ARGUMENTS", q, err, nsql.ErrNoArguments,
+		"invalid bindVar for IN query, does not have argument")
+}
+
+func TestBuildE_UpdateNoColumns(t *testing.T) {
+	q, err := query.Update(person, "id").BuildE()
+	testBuildError(t, "UPDATE NO COLUMNS", q, err, nsql.ErrNoColumns,
+		`no column defined on update table "Person"`)
+}
+
+func TestBuildE_JoinUndeclaredTable(t *testing.T) {
+	pSchema := schema.New(schema.FromModelRef(Person{}), schema.As("p"))
+	voSchema := schema.New(schema.FromModelRef(VehicleOwnership{}), schema.As("vo"))
+
+	q, err := query.Select(query.Column("*")).
+		From(voSchema).
+		Join(vehicle, query.Equal(query.Column("id", option.Schema(pSchema)), query.On("id"))).
+		BuildE()
+	testBuildError(t, "JOIN UNDECLARED TABLE", q, err, nsql.ErrUnknownTable,
+		`table "Person" is not declared in Query Builder`)
+}
+
+func TestBuildE_NoError(t *testing.T) {
+	q, err := query.Select(query.Column("*")).From(person).Where(query.Equal(query.Column("id"))).BuildE()
+	if err != nil {
+		t.Errorf("Unexpected error. Error=%s", err)
+	}
+	test_utils.CompareString(t, "NO ERROR", q, "SELECT `Person`.`createdAt`, `Person`.`updatedAt`, `Person`.`id`, `Person`.`fullName` FROM `Person` WHERE `Person`.`id` = ?")
+}
+
+func TestRecoverBuildError_Count(t *testing.T) {
+	build := func() (q string, err error) {
+		defer nsql.RecoverBuildError(&err)
+		return query.Select(query.Count("naem", option.Schema(person))).From(person).Build(), nil
+	}
+
+	q, err := build()
+	testBuildError(t, "RECOVER COUNT", q, err, nsql.ErrUnknownColumn,
+		`column "naem" is not declared in schema "Person"`)
+}
+
+func TestRecoverBuildError_RePanic(t *testing.T) {
+	defer test_utils.RecoverPanic(t, "RE-PANIC", "unexpected")()
+	func() (err error) {
+		defer nsql.RecoverBuildError(&err)
+		panic(errors.New("unexpected"))
+	}()
+}
@@ -0,0 +1,64 @@
+package query_test
+
+import (
+	"github.com/nbs-go/nsql/mysql/query"
+	"github.com/nbs-go/nsql/test_utils"
+	"testing"
+)
+
+func TestInValues(t *testing.T) {
+	q, args := query.Select(query.Column("id")).
+		From(person).
+		Where(
+			query.InValues(query.Column("id"), []int64{1, 2, 3}),
+			query.NotInValues(query.Column("fullName"), []string{"john"}),
+		).
+		BuildWithArgs()
+
+	test_utils.CompareString(t, "IN VALUES", q,
+		"SELECT `Person`.`id` FROM `Person` WHERE `Person`.`id` IN (?, ?, ?) AND `Person`.`fullName` NOT IN (?)")
+	test_utils.CompareInterfaceArray(t, "IN VALUES ARGS", args, []interface{}{int64(1), int64(2), int64(3), "john"})
+}
+
+func TestInValues_Empty(t *testing.T) {
+	q, args := query.Select(query.Column("id")).
+		From(person).
+		Where(
+			query.InValues(query.Column("id"), []int64{}),
+			query.NotInValues(query.Column("fullName"), nil),
+			query.Equal(query.Column("createdAt"), query.Value("2022-01-01")),
+		).
+		BuildWithArgs()
+
+	test_utils.CompareString(t, "EMPTY IN VALUES", q,
+		"SELECT `Person`.`id` FROM `Person` WHERE 1 = 0 AND 1 = 1 AND `Person`.`createdAt` = ?")
+	test_utils.CompareInterfaceArray(t, "EMPTY IN VALUES ARGS", args, []interface{}{"2022-01-01"})
+}
+
+func TestInValues_Delete(t *testing.T) {
+	q, args := query.Delete(person).
+		Where(query.InValues(query.Column("id"), []int{1, 2})).
+		BuildWithArgs()
+	test_utils.CompareString(t, "DELETE IN VALUES", q, "DELETE FROM `Person` WHERE `id` IN (?, ?)")
+	test_utils.CompareInterfaceArray(t, "DELETE IN VALUES ARGS", args, []interface{}{1, 2})
+}
+
+func TestInValues_NotSlice(t *testing.T) {
+	defer test_utils.RecoverPanic(t, "NOT SLICE", "nsql: values must be a slice, got int")()
+	query.InValues(query.Column("id"), 1)
+}
+
+func BenchmarkInValues1000(b *testing.B) {
+	values := make([]int64, 1000)
+	for i := range values {
+		values[i] = int64(i)
+	}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		query.Select(query.Column(`id`)).
+			From(person).
+			Where(query.InValues(query.Column(`id`), values)).
+			BuildWithArgs()
+	}
+}
@@ -334,6 +334,36 @@ func BenchmarkJoinManyToMany(b *testing.B) {
 	}
 }
 
+func BenchmarkLargeSelect(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		query.Select(
+			query.Column("*"),
+			query.Column("*", option.Schema(vehicleOwnership)),
+			query.Column("*", option.Schema(vehicle)),
+			query.Count(`id`, option.As(`total`)),
+		).
+			From(person).
+			Join(vehicleOwnership, query.Equal(query.Column(`id`), query.On(`personId`))).
+			Join(vehicle, query.Equal(query.Column(`vehicleId`, option.Schema(vehicleOwnership)), query.On(`id`))).
+			Where(
+				query.Equal(query.Column(`fullName`)),
+				query.Or(
+					query.GreaterThan(query.Column(`createdAt`)),
+					query.LessThanEqual(query.Column(`updatedAt`)),
+					query.IsNull(query.Column(`updatedAt`)),
+				),
+				query.Like(query.Column(`fullName`)),
+				query.NotEqual(query.Column(`id`, option.Schema(vehicle))),
+			).
+			OrderBy(`createdAt`, option.SortDirection(op.Descending)).
+			OrderBy(`id`).
+			Limit(10).
+			Skip(20).
+			Build()
+	}
+}
+
 func TestFromConstructor(t *testing.T) {
 	b := query.From(person)
 
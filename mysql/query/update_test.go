@@ -59,3 +59,9 @@ func TestUpdate(t *testing.T) {
 		"UPDATE `Transaction` SET `status` = ? WHERE `id` = ? AND `version` = ?",
 	)
 }
+
+func TestUpdate_DollarVarNotSupported(t *testing.T) {
+	defer test_utils.RecoverPanic(t, "DOLLAR VAR NOT SUPPORTED", "op.DollarVar variable format is not supported by MySQL")()
+	s := schema.New(schema.FromModelRef(new(Transaction)))
+	query.Update(s, "status").Build(option.VariableFormat(op.DollarVar))
+}
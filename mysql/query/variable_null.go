@@ -1,7 +0,0 @@
-package query
-
-type nullVar struct{}
-
-func (b *nullVar) VariableQuery() string {
-	return "NULL"
-}
@@ -5,4 +5,8 @@ type VariableFormat uint8
 const (
 	BindVar VariableFormat = iota
 	NamedVar
+	// DollarVar write bind variables as PostgreSQL positional placeholders, e.g. $1, $2
+	DollarVar
+	// AtPVar write bind variables as SQL Server positional placeholders, e.g. @p1, @p2
+	AtPVar
 )
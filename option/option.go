@@ -16,6 +16,9 @@ const (
 	VariableKey       = "variable"
 	VariableFormatKey = "varFmt"
 	ColumnFormatKey   = "columnFmt"
+	StrictKey         = "strict"
+	ValuesKey         = "values"
+	NamespaceKey      = "namespace"
 )
 
 type Options struct {
@@ -85,6 +88,31 @@ func (o *Options) GetColumnFormat() (op.ColumnFormat, bool) {
 	return f, fOk
 }
 
+// GetStrict returns strict mode option, if not set it will return default strict mode from nsql.IsStrictMode
+func (o *Options) GetStrict() bool {
+	v, ok := o.KV[StrictKey]
+	if !ok {
+		return nsql.IsStrictMode()
+	}
+
+	b, bOk := v.(bool)
+	return bOk && b
+}
+
+// GetValues returns values that are bound to variable. If not set, it will return nil
+func (o *Options) GetValues() []interface{} {
+	v, ok := o.KV[ValuesKey]
+	if !ok {
+		return nil
+	}
+	return v.([]interface{})
+}
+
+// GetNamespace returns namespace that override namespace of tables in query. If not set, it will return false
+func (o *Options) GetNamespace() (string, bool) {
+	return o.GetString(NamespaceKey)
+}
+
 func (o *Options) GetVariable(key string) nsql.VariableWriter {
 	v, ok := o.KV[key]
 	if !ok {
@@ -139,6 +167,22 @@ func ColumnFormat(f op.ColumnFormat) SetOptionFn {
 	}
 }
 
+// Strict override default strict mode that is set by nsql.SetStrictMode on BuildE. In strict mode, BuildE will return
+// nsql.DroppedError instead of dropping columns and conditions that are not declared in schema
+func Strict(enabled bool) SetOptionFn {
+	return func(o *Options) {
+		o.KV[StrictKey] = enabled
+	}
+}
+
+// Namespace override namespace of all tables in query on Build, e.g. PostgreSQL schema of tenant. Namespace must be a
+// safe identifier, otherwise Build will panic and BuildE will return nsql.ErrInvalidArgument
+func Namespace(ns string) SetOptionFn {
+	return func(o *Options) {
+		o.KV[NamespaceKey] = ns
+	}
+}
+
 // Evaluator
 
 func EvaluateOptions(args []interface{}) *Options {
@@ -7,4 +7,15 @@ const (
 	UnhandledError
 	UniqueError
 	FkViolationError
+	SerializationFailureError
+	DeadlockError
+	NotNullViolationError
+	CheckViolationError
+	ExclusionViolationError
+	LockTimeoutError
+	QueryCanceledError
+	UndefinedTableError
+	UndefinedColumnError
+	InsufficientPrivilegeError
+	ConnectionError
 )
@@ -3,6 +3,7 @@ package pqErr
 import (
 	"errors"
 	"github.com/lib/pq"
+	"strings"
 )
 
 func Parse(err error) (Code, *Metadata) {
@@ -16,6 +17,12 @@ func Parse(err error) (Code, *Metadata) {
 	meta := &Metadata{
 		Constraint: pErr.Constraint,
 		Message:    pErr.Message,
+		Detail:     pErr.Detail,
+		Hint:       pErr.Hint,
+		Schema:     pErr.Schema,
+		Table:      pErr.Table,
+		Column:     pErr.Column,
+		SQLState:   string(pErr.Code),
 	}
 
 	switch pErr.Code {
@@ -23,7 +30,32 @@ func Parse(err error) (Code, *Metadata) {
 		return UniqueError, meta
 	case "23503":
 		return FkViolationError, meta
-	default:
-		return UnhandledError, meta
+	case "40001":
+		return SerializationFailureError, meta
+	case "40P01":
+		return DeadlockError, meta
+	case "23502":
+		return NotNullViolationError, meta
+	case "23514":
+		return CheckViolationError, meta
+	case "23P01":
+		return ExclusionViolationError, meta
+	case "55P03":
+		return LockTimeoutError, meta
+	case "57014":
+		return QueryCanceledError, meta
+	case "42P01":
+		return UndefinedTableError, meta
+	case "42703":
+		return UndefinedColumnError, meta
+	case "42501":
+		return InsufficientPrivilegeError, meta
 	}
+
+	// Class 08 is connection exception
+	if strings.HasPrefix(string(pErr.Code), "08") {
+		return ConnectionError, meta
+	}
+
+	return UnhandledError, meta
 }
@@ -70,3 +70,80 @@ func TestUnhandledError(t *testing.T) {
 		t.Errorf("unexpected test result. metadata.Message is empty")
 	}
 }
+
+func TestSerializationFailureError(t *testing.T) {
+	// Prepare test case
+	sErr := &pq.Error{
+		Code:    "40001",
+		Message: "could not serialize access due to concurrent update",
+	}
+	expected := pqErr.SerializationFailureError
+	// Do test
+	actual, _ := pqErr.Parse(sErr)
+	if actual != expected {
+		t.Errorf("unexpected test result. Expected = %d, Actual = %d", expected, actual)
+	}
+}
+
+func TestDeadlockError(t *testing.T) {
+	// Prepare test case
+	sErr := &pq.Error{
+		Code:    "40P01",
+		Message: "deadlock detected",
+	}
+	expected := pqErr.DeadlockError
+	// Do test
+	actual, _ := pqErr.Parse(sErr)
+	if actual != expected {
+		t.Errorf("unexpected test result. Expected = %d, Actual = %d", expected, actual)
+	}
+}
+
+func TestSQLStateErrors(t *testing.T) {
+	// Prepare test case
+	testCases := map[pq.ErrorCode]pqErr.Code{
+		"23502": pqErr.NotNullViolationError,
+		"23514": pqErr.CheckViolationError,
+		"23P01": pqErr.ExclusionViolationError,
+		"55P03": pqErr.LockTimeoutError,
+		"57014": pqErr.QueryCanceledError,
+		"42P01": pqErr.UndefinedTableError,
+		"42703": pqErr.UndefinedColumnError,
+		"42501": pqErr.InsufficientPrivilegeError,
+		"08000": pqErr.ConnectionError,
+		"08006": pqErr.ConnectionError,
+	}
+
+	for code, expected := range testCases {
+		// Do test
+		actual, _ := pqErr.Parse(&pq.Error{Code: code})
+		if actual != expected {
+			t.Errorf("unexpected test result. SQLState = %s, Expected = %d, Actual = %d", code, expected, actual)
+		}
+	}
+}
+
+func TestMetadata(t *testing.T) {
+	// Prepare test case
+	sErr := &pq.Error{
+		Code:       "23502",
+		Message:    "null value in column violates not-null constraint",
+		Detail:     "Failing row contains (1, null).",
+		Hint:       "Set a value",
+		Schema:     "public",
+		Table:      "Vehicle",
+		Column:     "name",
+		Constraint: "",
+	}
+	// Do test
+	_, meta := pqErr.Parse(sErr)
+	if meta.SQLState != "23502" {
+		t.Errorf("unexpected test result. Expected SQLState = 23502, Actual = %s", meta.SQLState)
+	}
+	if meta.Table != "Vehicle" || meta.Column != "name" || meta.Schema != "public" {
+		t.Errorf("unexpected test result. metadata table, column or schema is not set")
+	}
+	if meta.Detail == "" || meta.Hint == "" {
+		t.Errorf("unexpected test result. metadata detail or hint is empty")
+	}
+}
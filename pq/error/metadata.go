@@ -3,4 +3,11 @@ package pqErr
 type Metadata struct {
 	Constraint string
 	Message    string
+	Detail     string
+	Hint       string
+	Schema     string
+	Table      string
+	Column     string
+	// SQLState is raw error code that is returned by Postgres, e.g. 23505
+	SQLState string
 }
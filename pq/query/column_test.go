@@ -0,0 +1,76 @@
+package query_test
+
+import (
+	"github.com/nbs-go/nsql/builder"
+	"github.com/nbs-go/nsql/op"
+	"github.com/nbs-go/nsql/option"
+	"github.com/nbs-go/nsql/pq/query"
+	"github.com/nbs-go/nsql/schema"
+	"github.com/nbs-go/nsql/test_utils"
+	"testing"
+)
+
+var (
+	personId       = schema.NewCol[int64](person, "id")
+	personFullName = schema.NewCol[string](person, "fullName")
+	voPersonId     = schema.NewCol[int64](vehicleOwnership, "personId")
+)
+
+func TestCol(t *testing.T) {
+	testSelectBuilder(t, "SELECT TYPED COLUMN",
+		query.Select(query.Col(personId), query.Col(personFullName, option.As("name"))).
+			From(person).
+			Where(query.Equal(query.Col(personFullName))).
+			OrderByColumn(query.Col(personId), option.SortDirection(op.Descending)),
+		`SELECT "Person"."id", "Person"."fullName" AS "name" FROM "Person" WHERE "Person"."fullName" = ? ORDER BY "Person"."id" DESC`,
+	)
+
+	testSelectBuilder(t, "SELECT TYPED COLUMN IN JOIN",
+		query.Select(query.Col(personFullName), query.Col(voPersonId)).
+			From(person).
+			Join(vehicleOwnership, query.Equal(query.Col(personId), query.On("personId"))),
+		`SELECT "Person"."fullName" AS "Person.fullName", "VehicleOwnership"."personId" AS "VehicleOwnership.personId" FROM "Person" INNER JOIN "VehicleOwnership" ON "Person"."id" = "VehicleOwnership"."personId"`,
+	)
+}
+
+func TestInsertCol(t *testing.T) {
+	test_utils.CompareString(t, "INSERT TYPED COLUMNS",
+		query.InsertCol(personId, personFullName).Build(),
+		`INSERT INTO "Person"("id", "fullName") VALUES (:id, :fullName) RETURNING "id"`)
+}
+
+func TestUpdateCol(t *testing.T) {
+	test_utils.CompareString(t, "UPDATE TYPED COLUMNS",
+		query.UpdateCol(personFullName).Where(query.Equal(query.Col(personId))).Build(),
+		`UPDATE "Person" SET "fullName" = :fullName WHERE "id" = :id`)
+}
+
+func TestPanicColDifferentSchema(t *testing.T) {
+	defer test_utils.RecoverPanic(t, "DIFFERENT SCHEMA", `column "personId" is not referred to schema "Person"`)()
+	query.InsertCol(personFullName, voPersonId)
+}
+
+// Typed conditions accept only value of column type, e.g. EqualCol(personId, "1") does not compile
+var _ func(schema.Col[int64], int64, ...interface{}) *builder.WhereCompareWriter = query.EqualCol[int64]
+var _ func(schema.Col[string], []string, ...interface{}) *builder.WhereCompareWriter = query.InCol[string]
+
+func TestTypedConditions(t *testing.T) {
+	q, args := query.Select(query.Col(personId)).
+		From(person).
+		Where(
+			query.EqualCol(personFullName, "John"),
+			query.GreaterThanCol(personId, int64(10)),
+			query.InCol(personId, []int64{1, 2}),
+		).
+		BuildWithArgs()
+
+	test_utils.CompareString(t, "TYPED CONDITIONS", q,
+		`SELECT "Person"."id" FROM "Person" WHERE "Person"."fullName" = ? AND "Person"."id" > ? AND "Person"."id" IN (?, ?)`)
+	test_utils.CompareInterfaceArray(t, "TYPED CONDITIONS ARGS", args, []interface{}{"John", int64(10), int64(1), int64(2)})
+}
+
+func TestOrderByCol(t *testing.T) {
+	testSelectBuilder(t, "ORDER BY TYPED COLUMN",
+		query.Select(query.Col(personId)).From(person).OrderByCol(personFullName, option.SortDirection(op.Descending)),
+		`SELECT "Person"."id" FROM "Person" ORDER BY "Person"."fullName" DESC`)
+}
@@ -0,0 +1,34 @@
+package query
+
+import (
+	"github.com/lib/pq"
+	"github.com/nbs-go/nsql"
+	"github.com/nbs-go/nsql/op"
+)
+
+// Dialect implements nsql.Dialect for PostgreSQL
+type Dialect struct {
+	nsql.StandardDialect
+}
+
+func (Dialect) Name() string {
+	return "PostgreSQL"
+}
+
+// BindType returns variable format that is used by PostgreSQL driver
+func (Dialect) BindType() op.VariableFormat {
+	return op.DollarVar
+}
+
+func (Dialect) Supports(f nsql.Feature) bool {
+	switch f {
+	case nsql.FeatureReturning, nsql.FeatureJSON, nsql.FeatureUpsert:
+		return true
+	}
+	return false
+}
+
+// BindArray bind values as PostgreSQL array with pq.Array
+func (Dialect) BindArray(values interface{}) interface{} {
+	return pq.Array(values)
+}
@@ -0,0 +1,82 @@
+package query_test
+
+import (
+	"github.com/nbs-go/nsql"
+	"github.com/nbs-go/nsql/op"
+	"github.com/nbs-go/nsql/option"
+	"github.com/nbs-go/nsql/pq/query"
+	"github.com/nbs-go/nsql/schema"
+	"github.com/nbs-go/nsql/test_utils"
+	"testing"
+)
+
+func TestDollarVar_Select(t *testing.T) {
+	pSchema := schema.New(schema.FromModelRef(Person{}), schema.As("p"))
+	voSchema := schema.New(schema.FromModelRef(VehicleOwnership{}), schema.As("vo"))
+
+	q, args := query.Select(query.Column("id")).
+		From(pSchema).
+		Join(voSchema, query.And(
+			query.Equal(query.Column("id"), query.On("personId")),
+			query.NotEqual(query.Column("vehicleId", option.Schema(voSchema)), query.Value(3)),
+		)).
+		Where(
+			query.Between(query.Column("createdAt"), query.Values("2022-01-01", "2022-12-31")),
+			query.In(query.Column("id"), 2, query.Values(1, 2)),
+		).
+		BuildWithArgs(option.VariableFormat(op.DollarVar))
+
+	test_utils.CompareString(t, "SELECT", q,
+		`SELECT "p"."id" AS "p.id" FROM "Person" AS "p" INNER JOIN "VehicleOwnership" AS "vo" ON "p"."id" = "vo"."personId" AND "vo"."vehicleId" != $1 WHERE "p"."createdAt" BETWEEN $2 AND $3 AND "p"."id" IN ($4, $5)`)
+	test_utils.CompareInterfaceArray(t, "SELECT ARGS", args, []interface{}{3, "2022-01-01", "2022-12-31", 1, 2})
+}
+
+func TestDollarVar_QuotedText(t *testing.T) {
+	q := query.Select(query.GreaterThan(query.Column("id"), option.As("is?"))).
+		From(person).
+		Where(query.Equal(query.Column("fullName"))).
+		Build(option.VariableFormat(op.DollarVar))
+	test_utils.CompareString(t, "QUOTED TEXT", q,
+		`SELECT "Person"."id" > $1 AS "is?" FROM "Person" WHERE "Person"."fullName" = $2`)
+}
+
+func TestDollarVar_Filter(t *testing.T) {
+	filters := query.NewFilter(map[string]string{
+		"id": "1",
+	}, map[string]nsql.FilterParser{
+		"id": query.EqualFilter(person, "id"),
+	})
+
+	q := query.Select(query.Column("id")).
+		From(person).
+		Where(filters.Conditions(), query.IsNotNull(query.Column("fullName"))).
+		Build(option.VariableFormat(op.DollarVar))
+	test_utils.CompareString(t, "FILTER", q,
+		`SELECT "Person"."id" FROM "Person" WHERE ("Person"."id" = $1) AND "Person"."fullName" IS NOT NULL`)
+}
+
+func TestDollarVar_Insert(t *testing.T) {
+	q := query.Insert(person, "fullName", "createdAt").Build(option.VariableFormat(op.DollarVar))
+	test_utils.CompareString(t, "INSERT", q,
+		`INSERT INTO "Person"("fullName", "createdAt") VALUES ($1, $2) RETURNING "id"`)
+}
+
+func TestDollarVar_Update(t *testing.T) {
+	q, args := query.Update(person, "fullName", "updatedAt").
+		Values("john", "2022-01-01").
+		Where(query.In(query.Column("id"), 2, query.Values(1, 2))).
+		BuildWithArgs(option.VariableFormat(op.DollarVar))
+	test_utils.CompareString(t, "UPDATE", q,
+		`UPDATE "Person" SET "fullName" = $1, "updatedAt" = $2 WHERE "id" IN ($3, $4)`)
+	test_utils.CompareInterfaceArray(t, "UPDATE ARGS", args, []interface{}{"john", "2022-01-01", 1, 2})
+}
+
+func TestDollarVar_SchemaBuilder(t *testing.T) {
+	sb := query.Schema(person)
+	test_utils.CompareString(t, "FIND BY PK", sb.FindByPK(option.VariableFormat(op.DollarVar)),
+		`SELECT "Person"."createdAt", "Person"."updatedAt", "Person"."id", "Person"."fullName" FROM "Person" WHERE "Person"."id" = $1`)
+	test_utils.CompareString(t, "UPDATE", sb.Update(option.VariableFormat(op.DollarVar)),
+		`UPDATE "Person" SET "createdAt" = $1, "updatedAt" = $2, "fullName" = $3 WHERE "id" = $4`)
+	test_utils.CompareString(t, "DELETE", sb.Delete(option.VariableFormat(op.DollarVar)),
+		`DELETE FROM "Person" WHERE "id" = $1`)
+}
@@ -31,3 +31,17 @@ func TestInsert(t *testing.T) {
 		`INSERT INTO "Person"("createdAt", "updatedAt", "id", "fullName") VALUES (:createdAt, :updatedAt, :id, :fullName) RETURNING "id"`,
 	)
 }
+
+func TestInsertOnConflict(t *testing.T) {
+	s := schema.New(schema.FromModelRef(new(Person)))
+
+	test_utils.CompareString(t, "ON CONFLICT DO UPDATE",
+		query.Insert(s, "id", "fullName").OnConflict("id").DoUpdate().Build(),
+		`INSERT INTO "Person"("id", "fullName") VALUES (:id, :fullName) ON CONFLICT ("id") DO UPDATE SET "fullName" = excluded."fullName" RETURNING "id"`,
+	)
+
+	test_utils.CompareString(t, "ON CONFLICT DO NOTHING",
+		query.Insert(s, "id", "fullName").OnConflict("id").Build(),
+		`INSERT INTO "Person"("id", "fullName") VALUES (:id, :fullName) ON CONFLICT ("id") DO NOTHING RETURNING "id"`,
+	)
+}
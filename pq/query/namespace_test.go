@@ -0,0 +1,111 @@
+package query_test
+
+import (
+	"errors"
+	"github.com/nbs-go/nsql"
+	"github.com/nbs-go/nsql/option"
+	"github.com/nbs-go/nsql/pq/query"
+	"github.com/nbs-go/nsql/schema"
+	"github.com/nbs-go/nsql/test_utils"
+	"testing"
+)
+
+type Invoice struct {
+	Id         int64 `db:"id"`
+	CustomerId int64 `db:"customerId"`
+	Amount     int64 `db:"amount"`
+}
+
+type InvoiceItem struct {
+	Id        int64  `db:"id"`
+	InvoiceId int64  `db:"invoiceId"`
+	Name      string `db:"name"`
+}
+
+func TestNamespace(t *testing.T) {
+	// Init schema
+	invoice := schema.New(schema.FromModelRef(Invoice{}), schema.TableName("invoices"), schema.Namespace("billing"))
+	item := schema.New(schema.FromModelRef(InvoiceItem{}), schema.TableName("invoice_items"),
+		schema.Namespace("billing"), schema.As("ii"))
+
+	test_utils.CompareString(t, "SELECT",
+		query.Select(query.Columns("id", "amount")).
+			From(invoice).
+			Where(query.Equal(query.Column("customerId"))).
+			Build(),
+		`SELECT "invoices"."id", "invoices"."amount" FROM "billing"."invoices" WHERE "invoices"."customerId" = ?`)
+
+	test_utils.CompareString(t, "JOIN",
+		query.Select(query.Column("id"), query.Column("name", option.Schema(item))).
+			From(invoice).
+			Join(item, query.Equal(query.Column("id"), query.On("invoiceId"))).
+			Build(),
+		`SELECT "invoices"."id" AS "invoices.id", "ii"."name" AS "ii.name" FROM "billing"."invoices" `+
+			`INNER JOIN "billing"."invoice_items" AS "ii" ON "invoices"."id" = "ii"."invoiceId"`)
+
+	test_utils.CompareString(t, "INSERT",
+		query.Insert(invoice, "*").Build(),
+		`INSERT INTO "billing"."invoices"("customerId", "amount") VALUES (:customerId, :amount) RETURNING "id"`)
+
+	test_utils.CompareString(t, "UPDATE",
+		query.Update(invoice, "amount").Build(),
+		`UPDATE "billing"."invoices" SET "amount" = :amount WHERE "id" = :id`)
+
+	test_utils.CompareString(t, "DELETE",
+		query.Delete(invoice).Build(),
+		`DELETE FROM "billing"."invoices" WHERE "id" = ?`)
+}
+
+func TestNamespaceOverride(t *testing.T) {
+	// Init schema without namespace
+	invoice := schema.New(schema.FromModelRef(Invoice{}), schema.TableName("orders"))
+	item := schema.New(schema.FromModelRef(InvoiceItem{}), schema.TableName("order_items"), schema.Namespace("public"))
+	tenant := option.Namespace("tenant_42")
+
+	// Build the same query for default and tenant namespace
+	b := query.Select(query.Column("id")).
+		From(invoice).
+		Join(item, query.Equal(query.Column("id"), query.On("invoiceId")))
+	test_utils.CompareString(t, "SELECT DEFAULT", b.Build(),
+		`SELECT "orders"."id" AS "orders.id" FROM "orders" INNER JOIN "public"."order_items" ON "orders"."id" = "order_items"."invoiceId"`)
+	test_utils.CompareString(t, "SELECT TENANT", b.Build(tenant),
+		`SELECT "orders"."id" AS "orders.id" FROM "tenant_42"."orders" INNER JOIN "tenant_42"."order_items" ON "orders"."id" = "order_items"."invoiceId"`)
+
+	sb := query.Schema(invoice)
+	test_utils.CompareString(t, "FIND BY PK", sb.FindByPK(tenant),
+		`SELECT "orders"."id", "orders"."customerId", "orders"."amount" FROM "tenant_42"."orders" WHERE "orders"."id" = ?`)
+	test_utils.CompareString(t, "INSERT", sb.Insert(tenant),
+		`INSERT INTO "tenant_42"."orders"("customerId", "amount") VALUES (:customerId, :amount) RETURNING "id"`)
+	test_utils.CompareString(t, "UPDATE", sb.Update(tenant),
+		`UPDATE "tenant_42"."orders" SET "customerId" = :customerId, "amount" = :amount WHERE "id" = :id`)
+	test_utils.CompareString(t, "DELETE", sb.Delete(tenant),
+		`DELETE FROM "tenant_42"."orders" WHERE "id" = ?`)
+}
+
+func TestNamespaceOverride_InvalidNamespace(t *testing.T) {
+	invoice := schema.New(schema.FromModelRef(Invoice{}), schema.TableName("orders"))
+
+	_, err := query.Select(query.Column("id")).From(invoice).BuildE(option.Namespace(`x"; DROP TABLE "orders`))
+	if !errors.Is(err, nsql.ErrInvalidArgument) {
+		t.Errorf("INVALID NAMESPACE: FAILED\n  > expected invalid argument error. Error = %v", err)
+	}
+
+	// Test BuildE of Insert, Update and Delete
+	_, err = query.Insert(invoice, query.AllColumns).BuildE(option.Namespace("bad-ns"))
+	if !errors.Is(err, nsql.ErrInvalidArgument) {
+		t.Errorf("INSERT INVALID NAMESPACE: FAILED\n  > expected invalid argument error. Error = %v", err)
+	}
+
+	_, err = query.Update(invoice, "amount").BuildE(option.Namespace("bad-ns"))
+	if !errors.Is(err, nsql.ErrInvalidArgument) {
+		t.Errorf("UPDATE INVALID NAMESPACE: FAILED\n  > expected invalid argument error. Error = %v", err)
+	}
+
+	_, err = query.Delete(invoice).BuildE(option.Namespace("bad-ns"))
+	if !errors.Is(err, nsql.ErrInvalidArgument) {
+		t.Errorf("DELETE INVALID NAMESPACE: FAILED\n  > expected invalid argument error. Error = %v", err)
+	}
+
+	defer test_utils.RecoverPanic(t, "INVALID NAMESPACE PANIC", `nsql: invalid identifier "tenant-42"`)()
+	query.Schema(invoice).Insert(option.Namespace("tenant-42"))
+}
@@ -0,0 +1,285 @@
+package query
+
+import (
+	"github.com/nbs-go/nsql"
+	"github.com/nbs-go/nsql/builder"
+	"github.com/nbs-go/nsql/op"
+	"github.com/nbs-go/nsql/option"
+	"github.com/nbs-go/nsql/schema"
+)
+
+// qb write query with PostgreSQL dialect
+var qb = builder.New(Dialect{})
+
+type (
+	SelectBuilder     = builder.SelectBuilder
+	InsertBuilder     = builder.InsertBuilder
+	UpdateBuilder     = builder.UpdateBuilder
+	DeleteBuilder     = builder.DeleteBuilder
+	SchemaBuilder     = builder.SchemaBuilder
+	FilterBuilder     = builder.FilterBuilder
+	LowerColumnWriter = builder.LowerColumnWriter
+	JsonColumnWriter  = builder.JsonColumnWriter
+)
+
+const AllColumns = builder.AllColumns
+
+func Column(col string, args ...interface{}) *builder.ColumnWriter {
+	return qb.Column(col, args...)
+}
+
+// Col create a column writer from a typed column reference, column will always be resolved to the referenced schema
+func Col(ref schema.ColumnRef, args ...interface{}) *builder.ColumnWriter {
+	return qb.Col(ref, args...)
+}
+
+func Columns(column1, column2 string, args ...interface{}) *builder.ColumnSchemaWriter {
+	return qb.Columns(column1, column2, args...)
+}
+
+func Count(column string, args ...interface{}) *builder.SelectCountWriter {
+	return qb.Count(column, args...)
+}
+
+func Lower(col nsql.ColumnWriter, args ...interface{}) *LowerColumnWriter {
+	return qb.Lower(col, args...)
+}
+
+func JsonColumn(column string, args ...interface{}) *JsonColumnWriter {
+	return qb.JsonColumn(column, args...)
+}
+
+func Select(column1 nsql.SelectWriter, columnN ...nsql.SelectWriter) *SelectBuilder {
+	return qb.Select(column1, columnN...)
+}
+
+func From(s *schema.Schema, args ...interface{}) *SelectBuilder {
+	return qb.From(s, args...)
+}
+
+func Insert(s *schema.Schema, column string, columnN ...string) *InsertBuilder {
+	return qb.Insert(s, column, columnN...)
+}
+
+// InsertCol create InsertBuilder from typed column references
+func InsertCol(ref1 schema.ColumnRef, refN ...schema.ColumnRef) *InsertBuilder {
+	return qb.InsertCol(ref1, refN...)
+}
+
+func Update(s *schema.Schema, column string, columnN ...string) *UpdateBuilder {
+	return qb.Update(s, column, columnN...)
+}
+
+// UpdateCol create UpdateBuilder from typed column references
+func UpdateCol(ref1 schema.ColumnRef, refN ...schema.ColumnRef) *UpdateBuilder {
+	return qb.UpdateCol(ref1, refN...)
+}
+
+// EqualCol create "=" condition of typed column reference. Value must be of column type T
+func EqualCol[T any](c schema.Col[T], v T, args ...interface{}) *builder.WhereCompareWriter {
+	return builder.EqualCol(qb, c, v, args...)
+}
+
+// NotEqualCol create "!=" condition of typed column reference with value of column type T
+func NotEqualCol[T any](c schema.Col[T], v T, args ...interface{}) *builder.WhereCompareWriter {
+	return builder.NotEqualCol(qb, c, v, args...)
+}
+
+// GreaterThanCol create ">" condition of typed column reference with value of column type T
+func GreaterThanCol[T any](c schema.Col[T], v T, args ...interface{}) *builder.WhereCompareWriter {
+	return builder.GreaterThanCol(qb, c, v, args...)
+}
+
+// GreaterThanEqualCol create ">=" condition of typed column reference with value of column type T
+func GreaterThanEqualCol[T any](c schema.Col[T], v T, args ...interface{}) *builder.WhereCompareWriter {
+	return builder.GreaterThanEqualCol(qb, c, v, args...)
+}
+
+// LessThanCol create "<" condition of typed column reference with value of column type T
+func LessThanCol[T any](c schema.Col[T], v T, args ...interface{}) *builder.WhereCompareWriter {
+	return builder.LessThanCol(qb, c, v, args...)
+}
+
+// LessThanEqualCol create "<=" condition of typed column reference with value of column type T
+func LessThanEqualCol[T any](c schema.Col[T], v T, args ...interface{}) *builder.WhereCompareWriter {
+	return builder.LessThanEqualCol(qb, c, v, args...)
+}
+
+// InCol create IN condition of typed column reference with values of column type T
+func InCol[T any](c schema.Col[T], values []T, args ...interface{}) *builder.WhereCompareWriter {
+	return builder.InCol(qb, c, values, args...)
+}
+
+// NotInCol create NOT IN condition of typed column reference with values of column type T
+func NotInCol[T any](c schema.Col[T], values []T, args ...interface{}) *builder.WhereCompareWriter {
+	return builder.NotInCol(qb, c, values, args...)
+}
+
+func Delete(s *schema.Schema) *DeleteBuilder {
+	return qb.Delete(s)
+}
+
+func Schema(s *schema.Schema) *SchemaBuilder {
+	return qb.Schema(s)
+}
+
+// Cached returns query that is cached by key, or write query with fn and cache it if key is not cached
+func Cached(key string, fn func() string) string {
+	return qb.Cached(key, fn)
+}
+
+func On(col string, args ...interface{}) option.SetOptionFn {
+	return qb.On(col, args...)
+}
+
+func Equal(col nsql.ColumnWriter, args ...interface{}) *builder.WhereCompareWriter {
+	return qb.Equal(col, args...)
+}
+
+func NotEqual(col nsql.ColumnWriter, args ...interface{}) *builder.WhereCompareWriter {
+	return qb.NotEqual(col, args...)
+}
+
+func GreaterThan(col nsql.ColumnWriter, args ...interface{}) *builder.WhereCompareWriter {
+	return qb.GreaterThan(col, args...)
+}
+
+func GreaterThanEqual(col nsql.ColumnWriter, args ...interface{}) *builder.WhereCompareWriter {
+	return qb.GreaterThanEqual(col, args...)
+}
+
+func LessThan(col nsql.ColumnWriter, args ...interface{}) *builder.WhereCompareWriter {
+	return qb.LessThan(col, args...)
+}
+
+func LessThanEqual(col nsql.ColumnWriter, args ...interface{}) *builder.WhereCompareWriter {
+	return qb.LessThanEqual(col, args...)
+}
+
+func Like(col nsql.ColumnWriter, args ...interface{}) *builder.WhereCompareWriter {
+	return qb.Like(col, args...)
+}
+
+func NotLike(col nsql.ColumnWriter, args ...interface{}) *builder.WhereCompareWriter {
+	return qb.NotLike(col, args...)
+}
+
+func ILike(col nsql.ColumnWriter, args ...interface{}) *builder.WhereCompareWriter {
+	return qb.ILike(col, args...)
+}
+
+func NotILike(col nsql.ColumnWriter, args ...interface{}) *builder.WhereCompareWriter {
+	return qb.NotILike(col, args...)
+}
+
+func Between(col nsql.ColumnWriter, args ...interface{}) *builder.WhereCompareWriter {
+	return qb.Between(col, args...)
+}
+
+func NotBetween(col nsql.ColumnWriter, args ...interface{}) *builder.WhereCompareWriter {
+	return qb.NotBetween(col, args...)
+}
+
+func In(col nsql.ColumnWriter, argCount int, args ...interface{}) *builder.WhereCompareWriter {
+	return qb.In(col, argCount, args...)
+}
+
+func NotIn(col nsql.ColumnWriter, argCount int, args ...interface{}) *builder.WhereCompareWriter {
+	return qb.NotIn(col, argCount, args...)
+}
+
+// InValues create IN condition that has bind variables for each item in values. Values must be a slice. If values is
+// empty, then condition will be written as an always false predicate. Use AnyArray option to write condition as
+// "= ANY(?)" that is bound with pq.Array
+func InValues(col nsql.ColumnWriter, values interface{}, args ...interface{}) *builder.WhereCompareWriter {
+	return qb.InValues(col, values, args...)
+}
+
+// NotInValues create NOT IN condition that has bind variables for each item in values. Values must be a slice. If
+// values is empty, then condition will be written as an always true predicate. Use AnyArray option to write condition
+// as "!= ALL(?)" that is bound with pq.Array
+func NotInValues(col nsql.ColumnWriter, values interface{}, args ...interface{}) *builder.WhereCompareWriter {
+	return qb.NotInValues(col, values, args...)
+}
+
+func IsNull(col nsql.ColumnWriter, args ...interface{}) *builder.WhereCompareWriter {
+	return qb.IsNull(col, args...)
+}
+
+func IsNotNull(col nsql.ColumnWriter, args ...interface{}) *builder.WhereCompareWriter {
+	return qb.IsNotNull(col, args...)
+}
+
+func And(cn ...nsql.WhereWriter) *builder.WhereLogicWriter {
+	return builder.And(cn...)
+}
+
+func Or(cn ...nsql.WhereWriter) *builder.WhereLogicWriter {
+	return builder.Or(cn...)
+}
+
+func BindVar() option.SetOptionFn {
+	return builder.BindVar()
+}
+
+func IntVar(i int) option.SetOptionFn {
+	return builder.IntVar(i)
+}
+
+func BoolVar(b bool) option.SetOptionFn {
+	return builder.BoolVar(b)
+}
+
+// Value set value that is bound to variable of condition. Use Values for condition that has more than one bind
+// variables, such as BETWEEN and IN
+func Value(v interface{}) option.SetOptionFn {
+	return builder.Value(v)
+}
+
+// Values set values that are bound to variables of condition in order of placeholders
+func Values(v ...interface{}) option.SetOptionFn {
+	return builder.Values(v...)
+}
+
+// AnyArray set IN condition to be written as "= ANY(?)" with values that are bound as a PostgreSQL array, so query
+// has the same placeholder regardless of values count. NOT IN condition will be written as "!= ALL(?)"
+func AnyArray() option.SetOptionFn {
+	return builder.AnyArray()
+}
+
+// NewFilter create a FilterBuilder that convert querystring to WHERE conditions
+func NewFilter(qs map[string]string, funcMap map[string]nsql.FilterParser) *FilterBuilder {
+	return builder.NewFilter(qs, funcMap)
+}
+
+func LikeFilter(col string, likeVar op.LikeVariable, args ...interface{}) nsql.FilterParser {
+	return qb.LikeFilter(col, likeVar, args...)
+}
+
+func EqualFilter(s *schema.Schema, col string) nsql.FilterParser {
+	return qb.EqualFilter(s, col)
+}
+
+func TimeGreaterThanEqualFilter(s *schema.Schema, col string, args ...string) nsql.FilterParser {
+	return qb.TimeGreaterThanEqualFilter(s, col, args...)
+}
+
+func TimeLessThanEqualFilter(s *schema.Schema, col string, args ...string) nsql.FilterParser {
+	return qb.TimeLessThanEqualFilter(s, col, args...)
+}
+
+func IntGreaterThanEqualFilter(s *schema.Schema, col string) nsql.FilterParser {
+	return qb.IntGreaterThanEqualFilter(s, col)
+}
+
+func IntLessThanEqualFilter(s *schema.Schema, col string) nsql.FilterParser {
+	return qb.IntLessThanEqualFilter(s, col)
+}
+
+func FloatGreaterThanEqualFilter(s *schema.Schema, col string) nsql.FilterParser {
+	return qb.FloatGreaterThanEqualFilter(s, col)
+}
+
+func FloatLessThanEqualFilter(s *schema.Schema, col string) nsql.FilterParser {
+	return qb.FloatLessThanEqualFilter(s, col)
+}
@@ -0,0 +1,99 @@
+package query_test
+
+import (
+	"github.com/nbs-go/nsql"
+	"github.com/nbs-go/nsql/option"
+	"github.com/nbs-go/nsql/pq/query"
+	"github.com/nbs-go/nsql/schema"
+	"github.com/nbs-go/nsql/test_utils"
+	"testing"
+)
+
+func testStrictError(t *testing.T, expectation string, q string, err error, expected string) {
+	t.Helper()
+	if err == nil {
+		t.Errorf("%s: FAILED\n  > expected error, got query: %s", expectation, q)
+		return
+	}
+	test_utils.CompareString(t, expectation, err.Error(), expected)
+}
+
+func TestStrict_Select(t *testing.T) {
+	b := query.Select(query.Columns("id", "naem")).
+		From(person).
+		Where(query.Equal(query.Column("gender"))).
+		OrderBy("craetedAt", option.Schema(person))
+
+	// Lenient mode
+	q, err := b.BuildE()
+	if err != nil {
+		t.Errorf("Unexpected error in lenient mode. Error=%s", err)
+	}
+	test_utils.CompareString(t, "LENIENT MODE", q, `SELECT "Person"."id" FROM "Person"`)
+
+	// Strict mode
+	q, err = b.BuildE(option.Strict(true))
+	testStrictError(t, "STRICT MODE", q, err,
+		`nsql: 3 query part(s) dropped in strict mode: SELECT column "naem" is not declared in schema; WHERE column "gender" is not declared in schema; ORDER BY column "craetedAt" is not declared in schema`)
+}
+
+func TestStrict_UndeclaredTable(t *testing.T) {
+	q, err := query.Select(query.Column("*"), query.Column("*", option.Schema(vehicle))).
+		From(person).
+		Where(query.Equal(query.Column("name", option.Schema(vehicle)))).
+		BuildE(option.Strict(true))
+	testStrictError(t, "UNDECLARED TABLE", q, err,
+		`nsql: 2 query part(s) dropped in strict mode: SELECT column "*" refers to table "Vehicle" that is not declared in query; WHERE column "name" refers to table "Vehicle" that is not declared in query`)
+}
+
+func TestStrict_Join(t *testing.T) {
+	pSchema := schema.New(schema.FromModelRef(Person{}), schema.As("p"))
+	voSchema := schema.New(schema.FromModelRef(VehicleOwnership{}), schema.As("vo"))
+
+	q, err := query.Select(query.Column("*")).
+		From(pSchema).
+		Join(voSchema, query.And(
+			query.Equal(query.Column("id"), query.On("personId")),
+			query.Equal(query.Column("vehicleId"), query.BindVar()),
+		)).
+		BuildE(option.Strict(true))
+	testStrictError(t, "JOIN", q, err,
+		`nsql: 1 query part(s) dropped in strict mode: JOIN column "vehicleId" is not declared in schema`)
+}
+
+func TestStrict_Global(t *testing.T) {
+	nsql.SetStrictMode(true)
+	defer nsql.SetStrictMode(false)
+
+	b := query.Select(query.Column("*")).From(person).OrderBy("age", option.Schema(person))
+	q, err := b.BuildE()
+	testStrictError(t, "GLOBAL STRICT MODE", q, err,
+		`nsql: 1 query part(s) dropped in strict mode: ORDER BY column "age" is not declared in schema`)
+
+	// Override global strict mode
+	q, err = b.BuildE(option.Strict(false))
+	if err != nil {
+		t.Errorf("Unexpected error on overriding strict mode. Error=%s", err)
+	}
+	test_utils.CompareString(t, "OVERRIDE GLOBAL STRICT MODE", q,
+		`SELECT "Person"."createdAt", "Person"."updatedAt", "Person"."id", "Person"."fullName" FROM "Person"`)
+}
+
+func TestStrict_InsertUpdate(t *testing.T) {
+	s := schema.New(schema.FromModelRef(new(Transaction)))
+
+	q, err := query.Insert(s, "status", "price").BuildE(option.Strict(true))
+	testStrictError(t, "INSERT", q, err,
+		`nsql: 1 query part(s) dropped in strict mode: INSERT column "price" is not declared in schema "Transaction"`)
+
+	q, err = query.Update(s, "status", "price").BuildE(option.Strict(true))
+	testStrictError(t, "UPDATE", q, err,
+		`nsql: 1 query part(s) dropped in strict mode: UPDATE column "price" is not declared in schema "Transaction"`)
+
+	q, err = query.Update(s, "status").BuildE(option.Strict(true))
+	if err != nil {
+		t.Errorf("Unexpected error. Error=%s", err)
+	}
+	test_utils.CompareString(t, "UPDATE WITHOUT DROPPED COLUMNS", q,
+		`UPDATE "Transaction" SET "status" = :status WHERE "id" = :id`)
+}
@@ -0,0 +1,186 @@
+// Package repo provides a generic repository that executes queries from SchemaBuilder and scans results to model.
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/nbs-go/nsql"
+	"github.com/nbs-go/nsql/op"
+	"github.com/nbs-go/nsql/option"
+	"github.com/nbs-go/nsql/schema"
+	"reflect"
+)
+
+// Executor is implemented by *sql.DB, *sql.Tx and *sql.Conn
+type Executor interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// SchemaBuilder is implemented by SchemaBuilder in pq/query and mysql/query
+type SchemaBuilder interface {
+	Schema() *schema.Schema
+	FindByPK(args ...interface{}) string
+	Insert(args ...interface{}) string
+	Update(args ...interface{}) string
+	Delete(args ...interface{}) string
+	Count(where nsql.WhereWriter, args ...interface{}) string
+	IsExists(where nsql.WhereWriter, args ...interface{}) string
+	List(where nsql.WhereWriter, args ...interface{}) string
+	BindType() op.VariableFormat
+	ReturnsPrimaryKey() bool
+}
+
+// Repository execute queries of a table and scan rows to model T. T must be a struct that is mapped to schema
+type Repository[T any] struct {
+	db      Executor
+	builder SchemaBuilder
+}
+
+// New create a Repository that execute queries with db
+func New[T any](db Executor, builder SchemaBuilder) *Repository[T] {
+	return &Repository[T]{
+		db:      db,
+		builder: builder,
+	}
+}
+
+// WithTx returns a copy of Repository that execute queries in transaction
+func (r *Repository[T]) WithTx(tx Executor) *Repository[T] {
+	return &Repository[T]{
+		db:      tx,
+		builder: r.builder,
+	}
+}
+
+// FindByPK returns row by primary key. If row is not found, then it will return sql.ErrNoRows
+func (r *Repository[T]) FindByPK(ctx context.Context, pk interface{}) (*T, error) {
+	q := nsql.Rebind(r.builder.BindType(), r.builder.FindByPK())
+
+	rows, err := r.db.QueryContext(ctx, q, pk)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := nsql.ScanAll[T](rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(result) == 0 {
+		return nil, sql.ErrNoRows
+	}
+	return &result[0], nil
+}
+
+// Insert insert model and set primary key that is generated by database to model
+func (r *Repository[T]) Insert(ctx context.Context, m *T) error {
+	q, args, err := nsql.BindNamedFormat(r.builder.BindType(), r.builder.Insert(option.VariableFormat(op.NamedVar)), m)
+	if err != nil {
+		return err
+	}
+
+	// Get primary key field
+	pk, err := r.primaryKeyField(m)
+	if err != nil {
+		return err
+	}
+
+	// If query returns primary key, then scan returned row to primary key field
+	if r.builder.ReturnsPrimaryKey() {
+		return r.db.QueryRowContext(ctx, q, args...).Scan(pk.Addr().Interface())
+	}
+
+	result, err := r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+
+	// If primary key is not generated by database, then return
+	if !r.builder.Schema().AutoIncrement() {
+		return nil
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	switch pk.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		pk.SetInt(id)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		pk.SetUint(uint64(id))
+	default:
+		return fmt.Errorf("nsql: unable to set last insert id to primary key field of type %s", pk.Type())
+	}
+	return nil
+}
+
+// Update update all columns of model by primary key. It returns count of affected rows
+func (r *Repository[T]) Update(ctx context.Context, m *T) (int64, error) {
+	q, args, err := nsql.BindNamedFormat(r.builder.BindType(), r.builder.Update(option.VariableFormat(op.NamedVar)), m)
+	if err != nil {
+		return 0, err
+	}
+	return r.exec(ctx, q, args)
+}
+
+// Delete delete row by primary key. It returns count of affected rows
+func (r *Repository[T]) Delete(ctx context.Context, pk interface{}) (int64, error) {
+	q := nsql.Rebind(r.builder.BindType(), r.builder.Delete())
+	return r.exec(ctx, q, []interface{}{pk})
+}
+
+// Count returns count of rows that match with where conditions. Args are values of bind variables in where
+func (r *Repository[T]) Count(ctx context.Context, where nsql.WhereWriter, args ...interface{}) (int64, error) {
+	q := nsql.Rebind(r.builder.BindType(), r.builder.Count(where))
+
+	var count int64
+	err := r.db.QueryRowContext(ctx, q, args...).Scan(&count)
+	return count, err
+}
+
+// Exists returns true if there is a row that match with where conditions. Args are values of bind variables in where
+func (r *Repository[T]) Exists(ctx context.Context, where nsql.WhereWriter, args ...interface{}) (bool, error) {
+	q := nsql.Rebind(r.builder.BindType(), r.builder.IsExists(where))
+
+	var ok bool
+	err := r.db.QueryRowContext(ctx, q, args...).Scan(&ok)
+	return ok, err
+}
+
+// List returns rows that match with where conditions. If where is nil, then all rows are returned. Args are values of
+// bind variables in where
+func (r *Repository[T]) List(ctx context.Context, where nsql.WhereWriter, args ...interface{}) ([]T, error) {
+	q := nsql.Rebind(r.builder.BindType(), r.builder.List(where))
+
+	rows, err := r.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return nsql.ScanAll[T](rows)
+}
+
+func (r *Repository[T]) exec(ctx context.Context, q string, args []interface{}) (int64, error) {
+	result, err := r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// primaryKeyField returns reflect value of primary key field in model
+func (r *Repository[T]) primaryKeyField(m *T) (reflect.Value, error) {
+	pk := r.builder.Schema().PrimaryKey()
+	rv := reflect.ValueOf(m).Elem()
+	for _, f := range schema.Fields(rv.Type()) {
+		if f.Column == pk {
+			return rv.FieldByIndexErr(f.Index)
+		}
+	}
+	return reflect.Value{}, fmt.Errorf(`nsql: primary key field "%s" is not found in %s`, pk, rv.Type())
+}
@@ -0,0 +1,234 @@
+package repo_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	mssqlQuery "github.com/nbs-go/nsql/mssql/query"
+	mysqlQuery "github.com/nbs-go/nsql/mysql/query"
+	"github.com/nbs-go/nsql/option"
+	"github.com/nbs-go/nsql/pq/query"
+	"github.com/nbs-go/nsql/repo"
+	"github.com/nbs-go/nsql/schema"
+	"github.com/nbs-go/nsql/test_utils"
+	"strings"
+	"testing"
+)
+
+type Customer struct {
+	Id       int64          `db:"id"`
+	FullName string         `db:"fullName"`
+	Email    sql.NullString `db:"email"`
+}
+
+var customer = schema.New(schema.FromModelRef(Customer{}))
+
+var customerColumns = []string{"id", "fullName", "email"}
+
+func TestRepository_FindByPK(t *testing.T) {
+	db := test_utils.OpenStubDB(func(q string, args []driver.Value) test_utils.StubResult {
+		if args[0] != int64(1) {
+			return test_utils.StubResult{Columns: customerColumns}
+		}
+		return test_utils.StubResult{
+			Columns: customerColumns,
+			Rows:    [][]driver.Value{{int64(1), "John", nil}},
+		}
+	})
+	defer db.Close()
+
+	r := repo.New[Customer](db.DB, query.Schema(customer))
+
+	// Test #1
+	c, err := r.FindByPK(context.Background(), int64(1))
+	if err != nil {
+		t.Errorf("Unexpected error. Error=%s", err)
+		return
+	}
+	test_utils.CompareString(t, "FIND BY PK", c.FullName, "John")
+	test_utils.CompareBoolean(t, "FIND BY PK NULL COLUMN", c.Email.Valid, false)
+	test_utils.CompareString(t, "FIND BY PK QUERY", db.Queries()[0],
+		`SELECT "Customer"."id", "Customer"."fullName", "Customer"."email" FROM "Customer" WHERE "Customer"."id" = $1`)
+
+	// Test #2
+	_, err = r.FindByPK(context.Background(), int64(2))
+	test_utils.CompareBoolean(t, "FIND BY PK NOT FOUND", err == sql.ErrNoRows, true)
+}
+
+func TestRepository_InsertReturning(t *testing.T) {
+	db := test_utils.OpenStubDB(func(q string, args []driver.Value) test_utils.StubResult {
+		return test_utils.StubResult{
+			Columns: []string{"id"},
+			Rows:    [][]driver.Value{{int64(10)}},
+		}
+	})
+	defer db.Close()
+
+	r := repo.New[Customer](db.DB, query.Schema(customer))
+
+	c := Customer{FullName: "John"}
+	if err := r.Insert(context.Background(), &c); err != nil {
+		t.Errorf("Unexpected error. Error=%s", err)
+		return
+	}
+
+	test_utils.CompareInt(t, "INSERT RETURNING PK", int(c.Id), 10)
+	test_utils.CompareString(t, "INSERT QUERY", db.Queries()[0],
+		`INSERT INTO "Customer"("fullName", "email") VALUES ($1, $2) RETURNING "id"`)
+}
+
+func TestRepository_InsertLastInsertId(t *testing.T) {
+	db := test_utils.OpenStubDB(func(q string, args []driver.Value) test_utils.StubResult {
+		return test_utils.StubResult{LastInsertId: 7, RowsAffected: 1}
+	})
+	defer db.Close()
+
+	r := repo.New[Customer](db.DB, mysqlQuery.Schema(customer))
+
+	c := Customer{FullName: "John"}
+	if err := r.Insert(context.Background(), &c); err != nil {
+		t.Errorf("Unexpected error. Error=%s", err)
+		return
+	}
+
+	test_utils.CompareInt(t, "INSERT LAST INSERT ID", int(c.Id), 7)
+	test_utils.CompareString(t, "INSERT QUERY", db.Queries()[0],
+		"INSERT INTO `Customer`(`fullName`, `email`) VALUES (?, ?)")
+}
+
+func TestRepository_InsertReturningInTableName(t *testing.T) {
+	db := test_utils.OpenStubDB(func(q string, args []driver.Value) test_utils.StubResult {
+		return test_utils.StubResult{LastInsertId: 9, RowsAffected: 1}
+	})
+	defer db.Close()
+
+	// Table name that contains RETURNING keyword must not be scanned as returned row
+	s := schema.New(schema.FromModelRef(Customer{}), schema.TableName("Log RETURNING Audit"))
+	r := repo.New[Customer](db.DB, mysqlQuery.Schema(s))
+
+	c := Customer{FullName: "John"}
+	if err := r.Insert(context.Background(), &c); err != nil {
+		t.Errorf("Unexpected error. Error=%s", err)
+		return
+	}
+
+	test_utils.CompareInt(t, "INSERT LAST INSERT ID", int(c.Id), 9)
+}
+
+func TestRepository_ReturnsPrimaryKey(t *testing.T) {
+	test_utils.CompareBoolean(t, "POSTGRES", query.Schema(customer).ReturnsPrimaryKey(), true)
+	test_utils.CompareBoolean(t, "MYSQL", mysqlQuery.Schema(customer).ReturnsPrimaryKey(), false)
+	test_utils.CompareBoolean(t, "SQL SERVER", mssqlQuery.Schema(customer).ReturnsPrimaryKey(), true)
+}
+
+func TestRepository_UpdateDelete(t *testing.T) {
+	var updateArgs []driver.Value
+	db := test_utils.OpenStubDB(func(q string, args []driver.Value) test_utils.StubResult {
+		if strings.HasPrefix(q, "UPDATE") {
+			updateArgs = args
+		}
+		return test_utils.StubResult{RowsAffected: 1}
+	})
+	defer db.Close()
+
+	r := repo.New[Customer](db.DB, query.Schema(customer))
+	ctx := context.Background()
+
+	// Test #1
+	n, err := r.Update(ctx, &Customer{Id: 1, FullName: "Jane"})
+	if err != nil {
+		t.Errorf("Unexpected error. Error=%s", err)
+		return
+	}
+	test_utils.CompareInt(t, "UPDATE ROWS AFFECTED", int(n), 1)
+	test_utils.CompareString(t, "UPDATE QUERY", db.Queries()[0],
+		`UPDATE "Customer" SET "fullName" = $1, "email" = $2 WHERE "id" = $3`)
+	test_utils.CompareInt(t, "UPDATE ARGS", len(updateArgs), 3)
+
+	// Test #2
+	n, err = r.Delete(ctx, int64(1))
+	if err != nil {
+		t.Errorf("Unexpected error. Error=%s", err)
+		return
+	}
+	test_utils.CompareInt(t, "DELETE ROWS AFFECTED", int(n), 1)
+	test_utils.CompareString(t, "DELETE QUERY", db.Queries()[1], `DELETE FROM "Customer" WHERE "id" = $1`)
+}
+
+func TestRepository_ListCountExists(t *testing.T) {
+	db := test_utils.OpenStubDB(func(q string, args []driver.Value) test_utils.StubResult {
+		switch {
+		case strings.Contains(q, `AS "count"`):
+			return test_utils.StubResult{Columns: []string{"count"}, Rows: [][]driver.Value{{int64(2)}}}
+		case strings.Contains(q, `AS "isExists"`):
+			return test_utils.StubResult{Columns: []string{"isExists"}, Rows: [][]driver.Value{{true}}}
+		}
+		return test_utils.StubResult{
+			Columns: append(customerColumns, "unknown"),
+			Rows: [][]driver.Value{
+				{int64(1), "John", "john@example.com", "x"},
+				{int64(2), "Johnny", nil, "y"},
+			},
+		}
+	})
+	defer db.Close()
+
+	r := repo.New[Customer](db.DB, query.Schema(customer))
+	ctx := context.Background()
+	where := query.Like(query.Column("fullName", option.Schema(customer)))
+
+	// Test #1
+	list, err := r.List(ctx, where, "John%")
+	if err != nil {
+		t.Errorf("Unexpected error. Error=%s", err)
+		return
+	}
+	test_utils.CompareInt(t, "LIST COUNT", len(list), 2)
+	test_utils.CompareString(t, "LIST ITEM", list[0].Email.String, "john@example.com")
+	test_utils.CompareString(t, "LIST QUERY", db.Queries()[0],
+		`SELECT "Customer"."id", "Customer"."fullName", "Customer"."email" FROM "Customer" WHERE "Customer"."fullName" LIKE $1`)
+
+	// Test #2
+	count, err := r.Count(ctx, where, "John%")
+	if err != nil {
+		t.Errorf("Unexpected error. Error=%s", err)
+		return
+	}
+	test_utils.CompareInt(t, "COUNT", int(count), 2)
+
+	// Test #3
+	ok, err := r.Exists(ctx, where, "John%")
+	if err != nil {
+		t.Errorf("Unexpected error. Error=%s", err)
+		return
+	}
+	test_utils.CompareBoolean(t, "EXISTS", ok, true)
+}
+
+func TestRepository_WithTx(t *testing.T) {
+	db := test_utils.OpenStubDB(func(q string, args []driver.Value) test_utils.StubResult {
+		return test_utils.StubResult{RowsAffected: 1}
+	})
+	defer db.Close()
+
+	r := repo.New[Customer](db.DB, query.Schema(customer))
+
+	tx, err := db.Begin()
+	if err != nil {
+		t.Errorf("Unexpected error. Error=%s", err)
+		return
+	}
+
+	if _, err = r.WithTx(tx).Delete(context.Background(), int64(1)); err != nil {
+		t.Errorf("Unexpected error. Error=%s", err)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		t.Errorf("Unexpected error. Error=%s", err)
+		return
+	}
+
+	test_utils.CompareStringArray(t, "TRANSACTION QUERIES", db.Queries(),
+		[]string{"BEGIN", `DELETE FROM "Customer" WHERE "id" = $1`, "COMMIT"})
+}
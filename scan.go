@@ -0,0 +1,207 @@
+package nsql
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/nbs-go/nsql/schema"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// ScanStruct scan current row to dest that must be a pointer to struct. Columns are mapped to fields with the same
+// rules as schema.FromModelRef. Column that is aliased as "table.column" by op.SelectJoinColumn is mapped to field of
+// nested struct that has "table" as its column name, for example:
+//
+//	type PersonVehicle struct {
+//		Person  Person   `db:"p"`
+//		Vehicle *Vehicle `db:"v"`
+//	}
+//
+// If nested struct is a pointer, it will be allocated only when one of its columns is not NULL. So the nested struct of
+// LEFT JOIN side that has no match is kept nil. Column that is not mapped to any field is discarded
+func ScanStruct(rows *sql.Rows, dest interface{}) error {
+	rv := reflect.ValueOf(dest)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("nsql: scan destination must be a non-nil pointer to struct, got %T", dest)
+	}
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+
+	fields := cachedColumnFields(rv.Elem().Type(), columns)
+	return scanRow(rows, rv.Elem(), fields)
+}
+
+// ScanAll scan all rows to a slice of T and close rows. T must be a struct. See ScanStruct for mapping rules
+func ScanAll[T any](rows *sql.Rows) ([]T, error) {
+	defer rows.Close()
+
+	var m T
+	t := reflect.TypeOf(m)
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("nsql: scan destination must be a struct, got %T", m)
+	}
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	fields := cachedColumnFields(t, columns)
+
+	result := make([]T, 0)
+	for rows.Next() {
+		var item T
+		if err = scanRow(rows, reflect.ValueOf(&item).Elem(), fields); err != nil {
+			return nil, err
+		}
+		result = append(result, item)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func scanRow(rows *sql.Rows, rv reflect.Value, fields []columnField) error {
+	dest := make([]interface{}, len(fields))
+	for i, f := range fields {
+		switch {
+		case f.index == nil:
+			dest[i] = new(interface{})
+		case f.nullable:
+			// Scan to pointer of field type, so NULL value will not allocate nested struct
+			dest[i] = reflect.New(reflect.PtrTo(f.typ)).Interface()
+		default:
+			dest[i] = fieldByIndexAlloc(rv, f.index).Addr().Interface()
+		}
+	}
+
+	if err := rows.Scan(dest...); err != nil {
+		return err
+	}
+
+	// Set values of fields in nested struct pointer
+	for i, f := range fields {
+		if !f.nullable {
+			continue
+		}
+		v := reflect.ValueOf(dest[i]).Elem()
+		if v.IsNil() {
+			continue
+		}
+		fieldByIndexAlloc(rv, f.index).Set(v.Elem())
+	}
+
+	return nil
+}
+
+// fieldByIndexAlloc returns nested field by index sequence and allocate nil struct pointer on the path
+func fieldByIndexAlloc(v reflect.Value, index []int) reflect.Value {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v
+}
+
+// columnField is a field that is mapped to column
+type columnField struct {
+	// index is index sequence of field. If column is not mapped, index is nil
+	index []int
+	// typ is type of field
+	typ reflect.Type
+	// nullable is true if field is in a nested struct pointer
+	nullable bool
+}
+
+type columnFieldsKey struct {
+	t       reflect.Type
+	columns string
+}
+
+var columnFieldsCache sync.Map
+
+// cachedColumnFields returns fields in order of columns
+func cachedColumnFields(t reflect.Type, columns []string) []columnField {
+	key := columnFieldsKey{t: t, columns: strings.Join(columns, ",")}
+	if v, ok := columnFieldsCache.Load(key); ok {
+		return v.([]columnField)
+	}
+
+	fields := make([]columnField, len(columns))
+	for i, c := range columns {
+		fields[i] = newColumnField(t, resolveColumnIndex(t, c))
+	}
+
+	v, _ := columnFieldsCache.LoadOrStore(key, fields)
+	return v.([]columnField)
+}
+
+func newColumnField(t reflect.Type, index []int) columnField {
+	f := columnField{index: index}
+	if index == nil {
+		return f
+	}
+
+	// Walk through index sequence to get field type and check if there is a struct pointer on the path
+	for i, x := range index {
+		if i > 0 && t.Kind() == reflect.Ptr {
+			f.nullable = true
+			t = t.Elem()
+		}
+		t = t.Field(x).Type
+	}
+	f.typ = t
+	return f
+}
+
+// resolveColumnIndex returns index sequence of field that is mapped to column
+func resolveColumnIndex(t reflect.Type, column string) []int {
+	fields := schema.Fields(t)
+
+	// Find field by column name
+	for _, f := range fields {
+		if f.Column == column {
+			return f.Index
+		}
+	}
+
+	// If column is aliased with table, then find field in nested struct
+	prefix, nested, ok := strings.Cut(column, ".")
+	if !ok {
+		return nil
+	}
+
+	for _, f := range fields {
+		if f.Column != prefix {
+			continue
+		}
+
+		// Get nested struct type
+		ft := t.FieldByIndex(f.Index).Type
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		if ft.Kind() != reflect.Struct {
+			return nil
+		}
+
+		nestedIndex := resolveColumnIndex(ft, nested)
+		if nestedIndex == nil {
+			return nil
+		}
+		return append(append([]int{}, f.Index...), nestedIndex...)
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package nsql_test
+
+import (
+	"database/sql/driver"
+	"github.com/nbs-go/nsql"
+	"github.com/nbs-go/nsql/op"
+	"github.com/nbs-go/nsql/option"
+	"github.com/nbs-go/nsql/pq/query"
+	"github.com/nbs-go/nsql/schema"
+	"github.com/nbs-go/nsql/test_utils"
+	"strings"
+	"testing"
+	"time"
+)
+
+type Order struct {
+	Id         int64  `db:"id"`
+	CustomerId int64  `db:"customerId"`
+	Status     string `db:"status"`
+}
+
+type OrderCustomer struct {
+	Order    Order     `db:"o"`
+	Customer *Customer `db:"c"`
+}
+
+func TestScanAll_Join(t *testing.T) {
+	oSchema := schema.New(schema.FromModelRef(Order{}), schema.As("o"))
+	cSchema := schema.New(schema.FromModelRef(Customer{}), schema.As("c"))
+
+	q := query.Select(query.Column("*"), query.Column("*", option.Schema(cSchema))).
+		From(oSchema).
+		Join(cSchema, query.Equal(query.Column("customerId"), query.On("id"))).
+		Build()
+
+	db := test_utils.OpenStubDB(func(q string, args []driver.Value) test_utils.StubResult {
+		return test_utils.StubResult{
+			Columns: []string{"o.id", "o.customerId", "o.status", "c.createdAt", "c.id", "c.fullName", "c.email"},
+			Rows: [][]driver.Value{
+				{int64(1), int64(2), "PAID", time.Now(), int64(2), "John", nil},
+			},
+		}
+	})
+	defer db.Close()
+
+	// Assert alias is produced by query
+	test_utils.CompareBoolean(t, "JOIN ALIAS", strings.Contains(q, `AS "c.fullName"`), true)
+
+	rows, err := db.Query(q)
+	if err != nil {
+		t.Errorf("Unexpected error. Error=%s", err)
+		return
+	}
+
+	result, err := nsql.ScanAll[OrderCustomer](rows)
+	if err != nil {
+		t.Errorf("Unexpected error. Error=%s", err)
+		return
+	}
+
+	test_utils.CompareInt(t, "ROW COUNT", len(result), 1)
+	test_utils.CompareString(t, "NESTED STRUCT", result[0].Order.Status, "PAID")
+	test_utils.CompareString(t, "NESTED POINTER STRUCT", result[0].Customer.FullName, "John")
+	test_utils.CompareInt(t, "NESTED EMBEDDED FIELD", int(result[0].Customer.Id), 2)
+}
+
+func TestScanStruct(t *testing.T) {
+	db := test_utils.OpenStubDB(func(q string, args []driver.Value) test_utils.StubResult {
+		return test_utils.StubResult{
+			Columns: []string{"id", "fullName", "email", "age", "unknown"},
+			Rows:    [][]driver.Value{{int64(1), "John", "john@example.com", int64(20), "x"}},
+		}
+	})
+	defer db.Close()
+
+	rows, err := db.Query(`SELECT * FROM "Customer"`)
+	if err != nil {
+		t.Errorf("Unexpected error. Error=%s", err)
+		return
+	}
+	defer rows.Close()
+
+	var c Customer
+	for rows.Next() {
+		if err = nsql.ScanStruct(rows, &c); err != nil {
+			t.Errorf("Unexpected error. Error=%s", err)
+			return
+		}
+	}
+
+	test_utils.CompareInt(t, "EMBEDDED FIELD", int(c.Id), 1)
+	test_utils.CompareString(t, "NULLABLE FIELD", c.Email.String, "john@example.com")
+	test_utils.CompareInt(t, "SKIPPED FIELD", c.Age, 0)
+
+	// Test invalid destination
+	err = nsql.ScanStruct(rows, c)
+	test_utils.CompareBoolean(t, "INVALID DESTINATION", err != nil, true)
+}
+
+func TestScanAll_LeftJoinNull(t *testing.T) {
+	oSchema := schema.New(schema.FromModelRef(Order{}), schema.As("o"))
+	cSchema := schema.New(schema.FromModelRef(Customer{}), schema.As("c"))
+
+	q := query.Select(query.Column("*"), query.Column("*", option.Schema(cSchema))).
+		From(oSchema).
+		Join(cSchema, query.Equal(query.Column("customerId"), query.On("id")), option.JoinMethod(op.LeftJoin)).
+		Build()
+
+	db := test_utils.OpenStubDB(func(q string, args []driver.Value) test_utils.StubResult {
+		return test_utils.StubResult{
+			Columns: []string{"o.id", "o.customerId", "o.status", "c.createdAt", "c.id", "c.fullName", "c.email"},
+			Rows: [][]driver.Value{
+				{int64(1), int64(2), "PAID", time.Now(), int64(2), "John", nil},
+				{int64(2), int64(3), "NEW", nil, nil, nil, nil},
+			},
+		}
+	})
+	defer db.Close()
+
+	test_utils.CompareBoolean(t, "LEFT JOIN", strings.Contains(q, "LEFT JOIN"), true)
+
+	rows, err := db.Query(q)
+	if err != nil {
+		t.Errorf("Unexpected error. Error=%s", err)
+		return
+	}
+
+	result, err := nsql.ScanAll[OrderCustomer](rows)
+	if err != nil {
+		t.Errorf("Unexpected error. Error=%s", err)
+		return
+	}
+
+	test_utils.CompareInt(t, "ROW COUNT", len(result), 2)
+	test_utils.CompareString(t, "MATCHED JOIN", result[0].Customer.FullName, "John")
+	test_utils.CompareBoolean(t, "MATCHED JOIN NULL COLUMN", result[0].Customer.Email.Valid, false)
+	test_utils.CompareString(t, "UNMATCHED JOIN PARENT", result[1].Order.Status, "NEW")
+	test_utils.CompareBoolean(t, "UNMATCHED JOIN IS NIL", result[1].Customer == nil, true)
+}
@@ -0,0 +1,238 @@
+package schema
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"github.com/nbs-go/nsql/dsn"
+	"os"
+	"strings"
+)
+
+// Catalog is a table definition that is loaded from database catalog. Catalog can be cached to a JSON file, so Schema
+// can be built without connecting to database
+type Catalog struct {
+	Namespace     string   `json:"namespace,omitempty"`
+	TableName     string   `json:"tableName"`
+	Columns       []string `json:"columns"`
+	PrimaryKey    string   `json:"primaryKey"`
+	AutoIncrement bool     `json:"autoIncrement"`
+}
+
+// Schema create a new Schema from catalog. Option setters will override values that are loaded from catalog. It will
+// panic if catalog is invalid, use SchemaE to get error instead
+func (c *Catalog) Schema(args ...OptionSetterFn) *Schema {
+	s, err := c.SchemaE(args...)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// SchemaE create a new Schema from catalog and returns error instead of panic on invalid options
+func (c *Catalog) SchemaE(args ...OptionSetterFn) (*Schema, error) {
+	return NewE(c.options(args)...)
+}
+
+func (c *Catalog) options(args []OptionSetterFn) []OptionSetterFn {
+	opts := []OptionSetterFn{
+		TableName(c.TableName),
+		Columns(c.Columns...),
+		PrimaryKey(c.PrimaryKey),
+		AutoIncrement(c.AutoIncrement),
+		Namespace(c.Namespace),
+	}
+	return append(opts, args...)
+}
+
+// WriteFile write catalog as JSON to file
+func (c *Catalog) WriteFile(path string) error {
+	b, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, b, 0644)
+}
+
+// ReadCatalogFile read catalog from JSON file that is written by Catalog.WriteFile
+func ReadCatalogFile(path string) (*Catalog, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var c Catalog
+	err = json.Unmarshal(b, &c)
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// LoadCatalog query database catalog to retrieve table columns, primary key and auto increment flag. Use Driver
+// option setter to query MySQL catalog, and Namespace option setter to query table in other schema or database than
+// current one
+func LoadCatalog(db *sql.DB, table string, args ...OptionSetterFn) (*Catalog, error) {
+	o := evaluateSchemaOptions(args)
+
+	var c *Catalog
+	var err error
+	switch o.driver {
+	case dsn.DriverPostgres:
+		c, err = loadPostgresCatalog(db, o.namespace, table)
+	case dsn.DriverMysql:
+		c, err = loadMysqlCatalog(db, o.namespace, table)
+	default:
+		return nil, fmt.Errorf("nsql: unsupported catalog driver %s", o.driver)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate result
+	if len(c.Columns) == 0 {
+		return nil, fmt.Errorf(`nsql: table "%s" is not found in catalog`, table)
+	}
+
+	if c.PrimaryKey == "" {
+		return nil, fmt.Errorf(`nsql: table "%s" has no primary key`, table)
+	}
+
+	return c, nil
+}
+
+// FromCatalog create a new Schema from database catalog
+func FromCatalog(db *sql.DB, table string, args ...OptionSetterFn) (*Schema, error) {
+	c, err := LoadCatalog(db, table, args...)
+	if err != nil {
+		return nil, err
+	}
+	return c.SchemaE(args...)
+}
+
+// FromCatalogFile create a new Schema from catalog that is cached in JSON file
+func FromCatalogFile(path string, args ...OptionSetterFn) (*Schema, error) {
+	c, err := ReadCatalogFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return c.SchemaE(args...)
+}
+
+const (
+	// pgSchemaFilter resolves namespace to current schema if it is not set, so columns and primary key are queried from
+	// the same table
+	pgSchemaFilter = `COALESCE(NULLIF($2, ''), current_schema())`
+	pgColumnsQuery = `SELECT column_name, COALESCE(column_default, ''), is_identity FROM information_schema.columns ` +
+		`WHERE table_schema = ` + pgSchemaFilter + ` AND table_name = $1 ORDER BY ordinal_position`
+	pgPrimaryKeyQuery = `SELECT a.attname FROM pg_index i ` +
+		`JOIN pg_class c ON c.oid = i.indrelid ` +
+		`JOIN pg_namespace n ON n.oid = c.relnamespace ` +
+		`JOIN pg_attribute a ON a.attrelid = i.indrelid AND a.attnum = ANY(i.indkey) ` +
+		`WHERE n.nspname = ` + pgSchemaFilter + ` AND c.relname = $1 AND i.indisprimary`
+	mysqlColumnsQuery = `SELECT COLUMN_NAME, COLUMN_KEY, EXTRA FROM information_schema.columns ` +
+		`WHERE TABLE_SCHEMA = COALESCE(NULLIF(?, ''), DATABASE()) AND TABLE_NAME = ? ORDER BY ORDINAL_POSITION`
+)
+
+func loadPostgresCatalog(db *sql.DB, namespace, table string) (*Catalog, error) {
+	c := Catalog{Namespace: namespace, TableName: table}
+
+	// Get columns
+	rows, err := db.Query(pgColumnsQuery, table, namespace)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	autoIncrements := make(map[string]bool)
+	for rows.Next() {
+		var name, def, identity string
+		if err = rows.Scan(&name, &def, &identity); err != nil {
+			return nil, err
+		}
+		c.Columns = append(c.Columns, name)
+		autoIncrements[name] = strings.HasPrefix(def, "nextval(") || identity == "YES"
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// If table is not found, then return early before querying index
+	if len(c.Columns) == 0 {
+		return &c, nil
+	}
+
+	// Get primary key
+	pkRows, err := db.Query(pgPrimaryKeyQuery, table, namespace)
+	if err != nil {
+		return nil, err
+	}
+	defer pkRows.Close()
+
+	var pks []string
+	for pkRows.Next() {
+		var pk string
+		if err = pkRows.Scan(&pk); err != nil {
+			return nil, err
+		}
+		pks = append(pks, pk)
+	}
+	if err = pkRows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err = c.setPrimaryKey(pks, autoIncrements); err != nil {
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+func loadMysqlCatalog(db *sql.DB, namespace, table string) (*Catalog, error) {
+	c := Catalog{Namespace: namespace, TableName: table}
+
+	rows, err := db.Query(mysqlColumnsQuery, namespace, table)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var pks []string
+	autoIncrements := make(map[string]bool)
+	for rows.Next() {
+		var name, key, extra string
+		if err = rows.Scan(&name, &key, &extra); err != nil {
+			return nil, err
+		}
+		c.Columns = append(c.Columns, name)
+		autoIncrements[name] = strings.Contains(strings.ToLower(extra), "auto_increment")
+
+		if key == "PRI" {
+			pks = append(pks, name)
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err = c.setPrimaryKey(pks, autoIncrements); err != nil {
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+func (c *Catalog) setPrimaryKey(pks []string, autoIncrements map[string]bool) error {
+	switch len(pks) {
+	case 0:
+		return nil
+	case 1:
+		c.PrimaryKey = pks[0]
+		c.AutoIncrement = autoIncrements[pks[0]]
+		return nil
+	default:
+		return fmt.Errorf(`nsql: composite primary key is not supported. Table "%s" has primary keys %s`,
+			c.TableName, strings.Join(pks, ", "))
+	}
+}
@@ -0,0 +1,180 @@
+package schema
+
+import (
+	"database/sql/driver"
+	"errors"
+	"github.com/nbs-go/nsql/dsn"
+	"github.com/nbs-go/nsql/test_utils"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func newPostgresCatalogDB(pkColumns ...string) *test_utils.StubDB {
+	return test_utils.OpenStubDB(func(q string, args []driver.Value) test_utils.StubResult {
+		// Return empty table if requested table is not "Customer"
+		if args[0] != "Customer" {
+			return test_utils.StubResult{Columns: []string{"column_name"}}
+		}
+
+		if strings.Contains(q, "information_schema.columns") {
+			return test_utils.StubResult{
+				Columns: []string{"column_name", "column_default", "is_identity"},
+				Rows: [][]driver.Value{
+					{"id", "nextval('\"Customer_id_seq\"'::regclass)", "NO"},
+					{"createdAt", "", "NO"},
+					{"fullName", "", "NO"},
+				},
+			}
+		}
+
+		rows := make([][]driver.Value, len(pkColumns))
+		for i, c := range pkColumns {
+			rows[i] = []driver.Value{c}
+		}
+		return test_utils.StubResult{Columns: []string{"attname"}, Rows: rows}
+	})
+}
+
+func TestFromCatalog_Postgres(t *testing.T) {
+	db := newPostgresCatalogDB("id")
+	defer db.Close()
+
+	s, err := FromCatalog(db.DB, "Customer", As("c"))
+	if err != nil {
+		t.Errorf("Unexpected error. Error=%s", err)
+		return
+	}
+
+	test_utils.CompareString(t, "TABLE NAME", s.TableName(), "Customer")
+	test_utils.CompareStringArray(t, "COLUMNS", s.Columns(), []string{"id", "createdAt", "fullName"})
+	test_utils.CompareString(t, "PRIMARY KEY", s.PrimaryKey(), "id")
+	test_utils.CompareBoolean(t, "AUTO INCREMENT", s.AutoIncrement(), true)
+	test_utils.CompareString(t, "ALIAS", s.As(), "c")
+	test_utils.CompareInt(t, "QUERY COUNT", len(db.Queries()), 2)
+}
+
+func TestFromCatalog_PostgresNamespace(t *testing.T) {
+	var namespaces []driver.Value
+	db := test_utils.OpenStubDB(func(q string, args []driver.Value) test_utils.StubResult {
+		namespaces = append(namespaces, args[1])
+		if strings.Contains(q, "information_schema.columns") {
+			return test_utils.StubResult{
+				Columns: []string{"column_name", "column_default", "is_identity"},
+				Rows:    [][]driver.Value{{"id", "", "YES"}, {"amount", "", "NO"}},
+			}
+		}
+		return test_utils.StubResult{Columns: []string{"attname"}, Rows: [][]driver.Value{{"id"}}}
+	})
+	defer db.Close()
+
+	s, err := FromCatalog(db.DB, "invoices", Namespace("billing"))
+	if err != nil {
+		t.Errorf("Unexpected error. Error=%s", err)
+		return
+	}
+
+	test_utils.CompareString(t, "NAMESPACE", s.Namespace(), "billing")
+	test_utils.CompareInt(t, "QUERY COUNT", len(namespaces), 2)
+	for _, ns := range namespaces {
+		test_utils.CompareString(t, "NAMESPACE ARGUMENT", ns.(string), "billing")
+	}
+
+	// Columns and primary key must be queried with the same schema filter
+	for _, q := range db.Queries() {
+		test_utils.CompareBoolean(t, "SCHEMA FILTER", strings.Contains(q, pgSchemaFilter), true)
+	}
+}
+
+func TestFromCatalog_PostgresCompositeKey(t *testing.T) {
+	db := newPostgresCatalogDB("id", "fullName")
+	defer db.Close()
+
+	_, err := FromCatalog(db.DB, "Customer")
+	if err == nil {
+		t.Errorf("Unexpected result. Function must return error")
+		return
+	}
+	test_utils.CompareString(t, "COMPOSITE PRIMARY KEY", err.Error(),
+		`nsql: composite primary key is not supported. Table "Customer" has primary keys id, fullName`)
+}
+
+func TestFromCatalog_TableNotFound(t *testing.T) {
+	db := newPostgresCatalogDB("id")
+	defer db.Close()
+
+	_, err := FromCatalog(db.DB, "Vendor")
+	if err == nil {
+		t.Errorf("Unexpected result. Function must return error")
+		return
+	}
+	test_utils.CompareString(t, "TABLE NOT FOUND", err.Error(), `nsql: table "Vendor" is not found in catalog`)
+	test_utils.CompareInt(t, "SKIP PRIMARY KEY QUERY", len(db.Queries()), 1)
+}
+
+func TestFromCatalog_Mysql(t *testing.T) {
+	db := test_utils.OpenStubDB(func(q string, args []driver.Value) test_utils.StubResult {
+		return test_utils.StubResult{
+			Columns: []string{"COLUMN_NAME", "COLUMN_KEY", "EXTRA"},
+			Rows: [][]driver.Value{
+				{"logId", "PRI", ""},
+				{"message", "", ""},
+			},
+		}
+	})
+	defer db.Close()
+
+	s, err := FromCatalog(db.DB, "Log", Driver(dsn.DriverMysql))
+	if err != nil {
+		t.Errorf("Unexpected error. Error=%s", err)
+		return
+	}
+
+	test_utils.CompareStringArray(t, "COLUMNS", s.Columns(), []string{"logId", "message"})
+	test_utils.CompareString(t, "PRIMARY KEY", s.PrimaryKey(), "logId")
+	test_utils.CompareBoolean(t, "AUTO INCREMENT", s.AutoIncrement(), false)
+	test_utils.CompareStringIn(t, "QUERY USE BIND VAR", db.Queries()[0], []string{mysqlColumnsQuery})
+}
+
+func TestCatalogFile(t *testing.T) {
+	db := newPostgresCatalogDB("id")
+	defer db.Close()
+
+	c, err := LoadCatalog(db.DB, "Customer")
+	if err != nil {
+		t.Errorf("Unexpected error. Error=%s", err)
+		return
+	}
+
+	// Write cache
+	path := filepath.Join(t.TempDir(), "Customer.json")
+	if err = c.WriteFile(path); err != nil {
+		t.Errorf("Unexpected error on writing catalog. Error=%s", err)
+		return
+	}
+
+	// Load from cache
+	s, err := FromCatalogFile(path, AutoIncrement(false))
+	if err != nil {
+		t.Errorf("Unexpected error on reading catalog. Error=%s", err)
+		return
+	}
+
+	test_utils.CompareString(t, "TABLE NAME", s.TableName(), "Customer")
+	test_utils.CompareStringArray(t, "COLUMNS", s.Columns(), c.Columns)
+	test_utils.CompareBoolean(t, "OVERRIDE AUTO INCREMENT", s.AutoIncrement(), false)
+}
+
+func TestCatalogFile_Invalid(t *testing.T) {
+	// Write catalog that has no primary key
+	c := Catalog{TableName: "Customer", Columns: []string{"id", "fullName"}}
+	path := filepath.Join(t.TempDir(), "Customer.json")
+	if err := c.WriteFile(path); err != nil {
+		t.Errorf("Unexpected error on writing catalog. Error=%s", err)
+		return
+	}
+
+	// Load from cache must return error instead of panic
+	_, err := FromCatalogFile(path)
+	test_utils.CompareBoolean(t, "INVALID CATALOG ERROR", errors.Is(err, ErrNoPrimaryKey), true)
+}
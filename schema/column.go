@@ -0,0 +1,44 @@
+package schema
+
+import "fmt"
+
+// ColumnRef is a reference to a column that is declared in a Schema
+type ColumnRef interface {
+	Name() string
+	Schema() *Schema
+}
+
+// Col is a typed reference to a column in Schema. Declare column reference once as variable, so column name is written
+// once and is validated by NewCol on initializing package. T is value type of column, that is checked on compile time
+// by typed conditions, such as EqualCol and InCol in query packages
+//
+//	var personFullName = schema.NewCol[string](person, "fullName")
+//	query.EqualCol(personFullName, "John")
+type Col[T any] struct {
+	schema *Schema
+	name   string
+}
+
+// NewCol create a typed reference to column in Schema. It will panic if column is not declared in schema
+func NewCol[T any](s *Schema, name string) Col[T] {
+	if !s.IsColumnExist(name) {
+		panic(fmt.Errorf(`column "%s" is not declared in schema "%s"`, name, s.TableName()))
+	}
+
+	return Col[T]{
+		schema: s,
+		name:   name,
+	}
+}
+
+func (c Col[T]) Name() string {
+	return c.name
+}
+
+func (c Col[T]) Schema() *Schema {
+	return c.schema
+}
+
+func (c Col[T]) String() string {
+	return c.name
+}
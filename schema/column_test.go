@@ -0,0 +1,24 @@
+package schema
+
+import (
+	"github.com/nbs-go/nsql/test_utils"
+	"testing"
+)
+
+func TestNewCol(t *testing.T) {
+	s := New(FromModelRef(Person{}))
+	c := NewCol[string](s, "fullName")
+
+	test_utils.CompareString(t, "COLUMN NAME", c.Name(), "fullName")
+	test_utils.CompareString(t, "COLUMN STRING", c.String(), "fullName")
+	test_utils.CompareString(t, "COLUMN SCHEMA", c.Schema().TableName(), "Person")
+
+	// Assert implements ColumnRef
+	var ref ColumnRef = c
+	test_utils.CompareString(t, "COLUMN REF", ref.Name(), "fullName")
+}
+
+func TestPanicNewColUndeclared(t *testing.T) {
+	defer test_utils.RecoverPanic(t, "UNDECLARED COLUMN", `column "naem" is not declared in schema "Person"`)()
+	NewCol[string](New(FromModelRef(Person{})), "naem")
+}
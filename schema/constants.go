@@ -1,6 +1,16 @@
 package schema
 
+import "errors"
+
 const (
 	DbTag     = "db"
 	SkipField = "-"
 )
+
+// Errors that are returned by NewE and raised as panic by New
+var (
+	ErrNoColumns       = errors.New("schema has no columns")
+	ErrNoTableName     = errors.New("schema has no table name")
+	ErrNoPrimaryKey    = errors.New("primary key is not defined in columns")
+	ErrInvalidModelRef = errors.New("modelRef must be a struct or pointer")
+)
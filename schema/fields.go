@@ -0,0 +1,76 @@
+package schema
+
+import "reflect"
+
+// Field is a struct field that is mapped to a column
+type Field struct {
+	Column string
+	// Index is an index sequence of field that can be used in reflect.Value FieldByIndex
+	Index []int
+}
+
+// Fields returns struct fields that are mapped to columns in order of declaration. Column name is resolved from db tag,
+// or field name if tag is not set. Fields of embedded struct are flattened, and field that is tagged with "-" or
+// unexported is skipped. If a column is declared more than once, the last declared field is used
+func Fields(t reflect.Type) []Field {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	var fields []Field
+	appendFields(&fields, t, nil)
+	return fields
+}
+
+func appendFields(fields *[]Field, t reflect.Type, parent []int) {
+	for i := 0; i < t.NumField(); i++ {
+		// Get field
+		f := t.Field(i)
+
+		// If field is unexported / private, then skip
+		if !f.IsExported() {
+			continue
+		}
+
+		// Copy index sequence
+		index := make([]int, len(parent)+1)
+		copy(index, parent)
+		index[len(parent)] = i
+
+		// If field is an embedded field, then get fields from embedded struct
+		if f.Anonymous {
+			et := f.Type
+			if et.Kind() == reflect.Ptr {
+				et = et.Elem()
+			}
+
+			if et.Kind() == reflect.Struct {
+				appendFields(fields, et, index)
+				continue
+			}
+		}
+
+		// Get config from tag
+		col := f.Tag.Get(DbTag)
+
+		// If skipped, then move to next field
+		if col == SkipField {
+			continue
+		}
+
+		// If empty, use field name
+		if col == "" {
+			col = f.Name
+		}
+
+		// Replace field that has been declared with the same column
+		for j, df := range *fields {
+			if df.Column == col {
+				*fields = append((*fields)[:j], (*fields)[j+1:]...)
+				break
+			}
+		}
+
+		*fields = append(*fields, Field{Column: col, Index: index})
+	}
+}
@@ -0,0 +1,33 @@
+package schema
+
+import (
+	"github.com/nbs-go/nsql/test_utils"
+	"reflect"
+	"testing"
+)
+
+type Audit struct {
+	CreatedBy string `db:"createdBy"`
+}
+
+type Account struct {
+	*Audit
+	Person
+	Balance int64 `db:"balance"`
+	secret  string
+}
+
+func TestFields(t *testing.T) {
+	fields := Fields(reflect.TypeOf(&Account{}))
+
+	columns := make([]string, len(fields))
+	for i, f := range fields {
+		columns[i] = f.Column
+	}
+	test_utils.CompareStringArray(t, "FIELD COLUMNS", columns,
+		[]string{"createdBy", "createdAt", "updatedAt", "id", "fullName", "birthDate", "NickName", "balance"})
+
+	// Check index of embedded field
+	f := reflect.TypeOf(Account{}).FieldByIndex(fields[0].Index)
+	test_utils.CompareString(t, "EMBEDDED POINTER FIELD", f.Name, "CreatedBy")
+}
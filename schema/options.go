@@ -1,5 +1,7 @@
 package schema
 
+import "github.com/nbs-go/nsql/dsn"
+
 type options struct {
 	tableName     string
 	columns       []string
@@ -7,6 +9,8 @@ type options struct {
 	autoIncrement bool
 	modelRef      interface{}
 	as            string
+	driver        string
+	namespace     string
 }
 
 var defaultOptions = &options{
@@ -16,6 +20,8 @@ var defaultOptions = &options{
 	autoIncrement: true,
 	modelRef:      nil,
 	as:            "",
+	driver:        dsn.DriverPostgres,
+	namespace:     "",
 }
 
 type OptionSetterFn func(*options)
@@ -70,3 +76,18 @@ func As(as string) OptionSetterFn {
 		o.as = as
 	}
 }
+
+// Namespace set PostgreSQL schema or MySQL database of table. Table name is qualified by namespace in FROM, JOIN,
+// INSERT, UPDATE and DELETE query, while columns are still referred by table name or alias
+func Namespace(ns string) OptionSetterFn {
+	return func(o *options) {
+		o.namespace = ns
+	}
+}
+
+// Driver set database driver that will be used to query catalog in FromCatalog, otherwise it will use postgres
+func Driver(d string) OptionSetterFn {
+	return func(o *options) {
+		o.driver = dsn.NormalizeDriver(d)
+	}
+}
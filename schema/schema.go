@@ -13,12 +13,18 @@ type Schema struct {
 	primaryKey    string
 	columns       map[string]int
 	as            string
+	namespace     string
 }
 
 func (s *Schema) TableName() string {
 	return s.tableName
 }
 
+// Namespace returns PostgreSQL schema or MySQL database of table. It returns empty string if table is not qualified
+func (s *Schema) Namespace() string {
+	return s.namespace
+}
+
 func (s *Schema) AutoIncrement() bool {
 	return s.autoIncrement
 }
@@ -93,6 +99,15 @@ func (s *Schema) Ref() Reference {
 }
 
 func New(args ...OptionSetterFn) *Schema {
+	s, err := NewE(args...)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// NewE create a new Schema and returns error instead of panic on invalid options
+func NewE(args ...OptionSetterFn) (*Schema, error) {
 	// Evaluate options
 	o := evaluateSchemaOptions(args)
 
@@ -101,11 +116,15 @@ func New(args ...OptionSetterFn) *Schema {
 
 	// If option has referenced model, then evaluate referenced model
 	if m := o.modelRef; m != nil {
-		s = evaluateModelRef(m)
+		var err error
+		s, err = evaluateModelRef(m)
+		if err != nil {
+			return nil, err
+		}
 	} else {
-		// If no columns set, then panic
+		// If no columns set, then return error
 		if len(o.columns) == 0 {
-			panic(fmt.Errorf("schema has no columns"))
+			return nil, ErrNoColumns
 		}
 
 		// Set columns
@@ -115,9 +134,9 @@ func New(args ...OptionSetterFn) *Schema {
 		}
 	}
 
-	// If table name is not set, then panic
+	// If table name is not set, then return error
 	if s.tableName == "" && o.tableName == "" {
-		panic(fmt.Errorf("schema has no table name"))
+		return nil, ErrNoTableName
 	}
 
 	// Set table name or override table name if already evaluated from model reference
@@ -128,18 +147,19 @@ func New(args ...OptionSetterFn) *Schema {
 	// Set other options
 	s.autoIncrement = o.autoIncrement
 	s.as = o.as
+	s.namespace = o.namespace
 
 	// Check if primary key is defined in columns
 	if _, ok := s.columns[o.primaryKey]; !ok {
-		panic(fmt.Errorf("primary key is not defined in columns"))
+		return nil, ErrNoPrimaryKey
 	}
 	s.primaryKey = o.primaryKey
 
-	return &s
+	return &s, nil
 }
 
 // evaluateModelRef returns Schema by evaluating struct
-func evaluateModelRef(m interface{}) Schema {
+func evaluateModelRef(m interface{}) (Schema, error) {
 	// Init schema
 	s := Schema{}
 
@@ -153,13 +173,13 @@ func evaluateModelRef(m interface{}) Schema {
 	case reflect.Struct:
 		break
 	default:
-		panic(fmt.Errorf("modelRef must be a struct or pointer. Got %s", t.Name()))
+		return s, fmt.Errorf("%w. Got %s", ErrInvalidModelRef, t.Name())
 	}
 
 	s.tableName = evaluateTableName(t)
 	s.columns = evaluateColumns(t)
 
-	return s
+	return s, nil
 }
 
 // evaluateTableName returns Table Name from struct name
@@ -169,63 +189,10 @@ func evaluateTableName(t reflect.Type) string {
 
 // evaluateColumns evaluate table Columns from struct fields
 func evaluateColumns(t reflect.Type) map[string]int {
-	// Init columns
-	columns := make(map[string]int)
-	pos := 0
-
-	// Get columns from tagged fields
-	for i := 0; i < t.NumField(); i++ {
-		// Get field
-		f := t.Field(i)
-
-		// If field is unexported / private, then skip
-		if !f.IsExported() {
-			continue
-		}
-
-		// If field is an embedded field
-		if f.Anonymous {
-			// Get type of embedded field
-			et := f.Type
-
-			// Check kind
-			switch et.Kind() {
-			case reflect.Struct:
-				break
-			case reflect.Ptr:
-				// Get struct type
-				et = et.Elem()
-			}
-
-			// Get columns from embedded struct
-			embColumns := evaluateColumns(et)
-
-			// Merge map
-			for k, ePos := range embColumns {
-				columns[k] = pos + ePos
-			}
-			pos += len(embColumns)
-
-			continue
-		}
-
-		// Get config from tag
-		col := f.Tag.Get(DbTag)
-
-		// If skipped, then move to next field
-		if col == SkipField {
-			continue
-		}
-
-		// If empty, use field name
-		if col == "" {
-			col = f.Name
-		}
-
-		// Append columns
-		columns[col] = pos
-		pos += 1
+	fields := Fields(t)
+	columns := make(map[string]int, len(fields))
+	for i, f := range fields {
+		columns[f.Column] = i
 	}
-
 	return columns
 }
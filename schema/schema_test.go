@@ -1,6 +1,7 @@
 package schema
 
 import (
+	"errors"
 	"github.com/nbs-go/nsql/test_utils"
 	"testing"
 	"time"
@@ -151,6 +152,32 @@ func TestPanicNoPrimaryKey(t *testing.T) {
 	New(TableName("Customer"), Columns("createdAt", "name"))
 }
 
+func TestNewE(t *testing.T) {
+	// Test #1
+	s, err := NewE(FromModelRef(Person{}))
+	if err != nil {
+		t.Errorf("Unexpected error. Error=%s", err)
+		return
+	}
+	test_utils.CompareString(t, "NEW SCHEMA", s.TableName(), "Person")
+
+	// Test #2
+	_, err = NewE(FromModelRef(""))
+	test_utils.CompareBoolean(t, "INVALID MODEL REF", errors.Is(err, ErrInvalidModelRef), true)
+
+	// Test #3
+	_, err = NewE(TableName("Customer"))
+	test_utils.CompareBoolean(t, "NO COLUMNS", errors.Is(err, ErrNoColumns), true)
+
+	// Test #4
+	_, err = NewE(Columns("id", "name"))
+	test_utils.CompareBoolean(t, "NO TABLE NAME", errors.Is(err, ErrNoTableName), true)
+
+	// Test #5
+	_, err = NewE(TableName("Customer"), Columns("createdAt", "name"))
+	test_utils.CompareBoolean(t, "NO PRIMARY KEY", errors.Is(err, ErrNoPrimaryKey), true)
+}
+
 func TestFilterColumns(t *testing.T) {
 	s := New(FromModelRef(Person{}))
 	test_utils.CompareStringArray(t, "FILTER COLUMNS",
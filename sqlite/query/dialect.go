@@ -0,0 +1,66 @@
+package query
+
+import (
+	"github.com/nbs-go/nsql"
+	"github.com/nbs-go/nsql/op"
+	"strings"
+)
+
+// Dialect implements nsql.Dialect for SQLite. RETURNING clause requires SQLite 3.35 or later
+type Dialect struct {
+	nsql.StandardDialect
+}
+
+func (Dialect) Name() string {
+	return "SQLite"
+}
+
+// Operator write ILIKE as LIKE, since LIKE in SQLite is case-insensitive for ASCII characters
+func (d Dialect) Operator(o op.Operator) string {
+	switch o {
+	case op.ILike:
+		return "LIKE"
+	case op.NotILike:
+		return "NOT LIKE"
+	}
+	return d.StandardDialect.Operator(o)
+}
+
+// WriteLimitQuery write LIMIT -1 if only offset is set, since SQLite does not accept OFFSET without LIMIT
+func (d Dialect) WriteLimitQuery(sb *strings.Builder, limit *int64, offset *int64) {
+	if limit == nil && offset != nil {
+		noLimit := int64(-1)
+		limit = &noLimit
+	}
+	d.StandardDialect.WriteLimitQuery(sb, limit, offset)
+}
+
+func (Dialect) Supports(f nsql.Feature) bool {
+	switch f {
+	case nsql.FeatureReturning, nsql.FeatureJSON, nsql.FeatureUpsert:
+		return true
+	}
+	return false
+}
+
+// JSONExtract write json_extract function with JSON path of attributes. Attribute that is not a safe identifier is
+// written as quoted key in JSON path
+func (d Dialect) JSONExtract(column string, attrs []string) string {
+	var path strings.Builder
+	path.WriteString("$")
+	for _, attr := range attrs {
+		path.WriteString(".")
+		if nsql.IsSafeIdent(attr) {
+			path.WriteString(attr)
+			continue
+		}
+
+		// SQLite JSON path has no escape sequence for double quote in quoted key
+		if strings.ContainsRune(attr, '"') {
+			panic(nsql.NewBuildError(nsql.ErrInvalidArgument, `nsql: JSON attribute "%s" is not supported by %s`, attr,
+				d.Name()))
+		}
+		path.WriteString(`"` + attr + `"`)
+	}
+	return "json_extract(" + column + ", " + d.QuoteLiteral(path.String()) + ")"
+}
@@ -0,0 +1,77 @@
+package query_test
+
+import (
+	"github.com/nbs-go/nsql/op"
+	"github.com/nbs-go/nsql/option"
+	"github.com/nbs-go/nsql/schema"
+	"github.com/nbs-go/nsql/sqlite/query"
+	"github.com/nbs-go/nsql/test_utils"
+	"testing"
+	"time"
+)
+
+type Person struct {
+	CreatedAt time.Time `db:"createdAt"`
+	UpdatedAt time.Time `db:"updatedAt"`
+	Id        int64     `db:"id"`
+	Email     string    `db:"email"`
+	FullName  string    `db:"fullName"`
+}
+
+type Content struct {
+	Id      int64  `db:"id"`
+	Content string `db:"content"`
+}
+
+var person = schema.New(schema.FromModelRef(Person{}))
+
+func TestSelect(t *testing.T) {
+	actual := query.Select(query.Columns("id", "fullName")).
+		From(person).
+		Where(query.Equal(query.Column("id"))).
+		Limit(10).
+		Build()
+	test_utils.CompareString(t, "SELECT", actual,
+		`SELECT "Person"."id", "Person"."fullName" FROM "Person" WHERE "Person"."id" = ? LIMIT 10`)
+
+	actual = query.Select(query.Column("id")).From(person).Skip(20).Build(option.VariableFormat(op.BindVar))
+	test_utils.CompareString(t, "SELECT WITH OFFSET ONLY", actual,
+		`SELECT "Person"."id" FROM "Person" LIMIT -1 OFFSET 20`)
+}
+
+func TestInsertReturning(t *testing.T) {
+	actual := query.Insert(person, "*").Build()
+	test_utils.CompareString(t, "INSERT RETURNING", actual,
+		`INSERT INTO "Person"("createdAt", "updatedAt", "email", "fullName") VALUES (:createdAt, :updatedAt, :email, :fullName) RETURNING "id"`)
+}
+
+func TestInsertOnConflict(t *testing.T) {
+	actual := query.Insert(person, "email", "fullName").OnConflict("email").DoUpdate().Build()
+	test_utils.CompareString(t, "ON CONFLICT DO UPDATE", actual,
+		`INSERT INTO "Person"("email", "fullName") VALUES (:email, :fullName) ON CONFLICT ("email") DO UPDATE SET "fullName" = excluded."fullName" RETURNING "id"`)
+
+	actual = query.Insert(person, "email", "fullName").OnConflict("email").DoNothing().Build()
+	test_utils.CompareString(t, "ON CONFLICT DO NOTHING", actual,
+		`INSERT INTO "Person"("email", "fullName") VALUES (:email, :fullName) ON CONFLICT ("email") DO NOTHING RETURNING "id"`)
+}
+
+func TestInsertOnConflict_UnknownColumn(t *testing.T) {
+	defer test_utils.RecoverPanic(t, "ON CONFLICT UNKNOWN COLUMN", `column "age" is not declared in schema "Person"`)()
+	query.Insert(person, "email", "fullName").OnConflict("age")
+}
+
+func TestJsonColumn(t *testing.T) {
+	content := schema.New(schema.FromModelRef(Content{}))
+	actual := query.Select(query.JsonColumn("content.meta.title", option.As("title"))).
+		From(content).
+		Build()
+	test_utils.CompareString(t, "JSON COLUMN", actual,
+		`SELECT (json_extract("Content"."content", '$.meta.title')) AS "title" FROM "Content"`)
+}
+
+func TestLikeFilter(t *testing.T) {
+	w, _ := query.LikeFilter("fullName", op.LikeSubString, option.Schema(person))("john")
+	actual := query.Select(query.Column("id")).From(person).Where(w).Build()
+	test_utils.CompareString(t, "CASE-INSENSITIVE LIKE", actual,
+		`SELECT "Person"."id" FROM "Person" WHERE "Person"."fullName" LIKE ?`)
+}
@@ -0,0 +1,195 @@
+package nsql
+
+import (
+	"container/list"
+	"context"
+	"database/sql"
+	"sync"
+)
+
+// DefaultStmtCacheSize is max count of prepared statements in StmtCache if size is not set
+const DefaultStmtCacheSize = 128
+
+// StmtPreparer is implemented by *sql.DB, *sql.Tx and *sql.Conn. QueryRowContext is used to return sql.Row with error
+// if statement fails to be prepared
+type StmtPreparer interface {
+	PrepareContext(ctx context.Context, query string) (*sql.Stmt, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// StmtCache prepares each distinct query once and reuses the prepared statement on next executions. If count of
+// prepared statements exceeds size, then the least recently used statement is closed.
+//
+// StmtCache implements ExecContext, QueryContext and QueryRowContext, so it can be used as repo.Executor. It is safe
+// for concurrent use
+type StmtCache struct {
+	db    StmtPreparer
+	size  int
+	mu    sync.Mutex
+	ll    *list.List
+	items map[string]*list.Element
+}
+
+// stmtEntry is a prepared statement in cache. Statement is closed after it is evicted and no longer used
+type stmtEntry struct {
+	query   string
+	stmt    *sql.Stmt
+	refs    int
+	evicted bool
+}
+
+// NewStmtCache create a StmtCache that prepares statements with db. If size is less than 1, then
+// DefaultStmtCacheSize is used
+func NewStmtCache(db StmtPreparer, size int) *StmtCache {
+	if size < 1 {
+		size = DefaultStmtCacheSize
+	}
+
+	return &StmtCache{
+		db:    db,
+		size:  size,
+		ll:    list.New(),
+		items: make(map[string]*list.Element),
+	}
+}
+
+// ExecContext execute query with prepared statement
+func (c *StmtCache) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	e, err := c.acquire(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer c.release(e)
+
+	return e.stmt.ExecContext(ctx, args...)
+}
+
+// QueryContext execute query with prepared statement. Statement is kept open until returned rows are closed
+func (c *StmtCache) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	e, err := c.acquire(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer c.release(e)
+
+	return e.stmt.QueryContext(ctx, args...)
+}
+
+// QueryRowContext execute query with prepared statement. If statement fails to be prepared, then query is executed
+// without cache, so the error is returned by Scan of sql.Row
+func (c *StmtCache) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	e, err := c.acquire(ctx, query)
+	if err != nil {
+		// Delegate to db, since sql.Row with error cannot be created outside database/sql
+		return c.db.QueryRowContext(ctx, query, args...)
+	}
+	defer c.release(e)
+
+	return e.stmt.QueryRowContext(ctx, args...)
+}
+
+// Len returns count of prepared statements in cache
+func (c *StmtCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ll.Len()
+}
+
+// Close close all prepared statements and clear cache. Statements that are being used are closed after executions
+// are finished
+func (c *StmtCache) Close() error {
+	c.mu.Lock()
+	var closing []*stmtEntry
+	for el := c.ll.Front(); el != nil; {
+		next := el.Next()
+		e := c.evict(el)
+		el = next
+		if e != nil {
+			closing = append(closing, e)
+		}
+	}
+	c.mu.Unlock()
+
+	var err error
+	for _, e := range closing {
+		if cErr := e.stmt.Close(); cErr != nil && err == nil {
+			err = cErr
+		}
+	}
+	return err
+}
+
+// Private methods
+
+// acquire returns cached statement of query, or prepare statement if query is not cached. Statement must be released
+// after use
+func (c *StmtCache) acquire(ctx context.Context, query string) (*stmtEntry, error) {
+	c.mu.Lock()
+	if el, ok := c.items[query]; ok {
+		c.ll.MoveToFront(el)
+		e := el.Value.(*stmtEntry)
+		e.refs++
+		c.mu.Unlock()
+		return e, nil
+	}
+	c.mu.Unlock()
+
+	// Prepare statement without lock, so other queries are not blocked
+	stmt, err := c.db.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+
+	// If query is cached concurrently, then use cached statement and close prepared statement
+	if el, ok := c.items[query]; ok {
+		c.ll.MoveToFront(el)
+		e := el.Value.(*stmtEntry)
+		e.refs++
+		c.mu.Unlock()
+		_ = stmt.Close()
+		return e, nil
+	}
+
+	e := &stmtEntry{query: query, stmt: stmt, refs: 1}
+	c.items[query] = c.ll.PushFront(e)
+
+	// Evict least recently used statement
+	var evicted *stmtEntry
+	if c.ll.Len() > c.size {
+		evicted = c.evict(c.ll.Back())
+	}
+	c.mu.Unlock()
+
+	if evicted != nil {
+		_ = evicted.stmt.Close()
+	}
+
+	return e, nil
+}
+
+// release decrease usage of statement and close it if statement has been evicted
+func (c *StmtCache) release(e *stmtEntry) {
+	c.mu.Lock()
+	e.refs--
+	closing := e.evicted && e.refs == 0
+	c.mu.Unlock()
+
+	if closing {
+		_ = e.stmt.Close()
+	}
+}
+
+// evict remove element from cache and returns entry if it can be closed immediately. Lock must be held by caller
+func (c *StmtCache) evict(el *list.Element) *stmtEntry {
+	e := el.Value.(*stmtEntry)
+	c.ll.Remove(el)
+	delete(c.items, e.query)
+	e.evicted = true
+
+	if e.refs > 0 {
+		return nil
+	}
+	return e
+}
@@ -0,0 +1,139 @@
+package nsql_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"github.com/nbs-go/nsql"
+	"github.com/nbs-go/nsql/test_utils"
+	"sync"
+	"testing"
+)
+
+func countPrepares(queries []string) map[string]int {
+	count := make(map[string]int)
+	for _, q := range queries {
+		if len(q) > 8 && q[:8] == "PREPARE " {
+			count[q[8:]]++
+		}
+	}
+	return count
+}
+
+func TestStmtCache_Reuse(t *testing.T) {
+	db := test_utils.OpenStubDB(func(q string, args []driver.Value) test_utils.StubResult {
+		return test_utils.StubResult{Columns: []string{"id"}, Rows: [][]driver.Value{{int64(1)}}, RowsAffected: 1}
+	})
+	defer db.Close()
+
+	c := nsql.NewStmtCache(db.DB, 0)
+	defer c.Close()
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		if _, err := c.ExecContext(ctx, `DELETE FROM "Person" WHERE "id" = $1`, i); err != nil {
+			t.Errorf("Unexpected error. Error=%s", err)
+			return
+		}
+
+		var id int64
+		if err := c.QueryRowContext(ctx, `SELECT "id" FROM "Person" WHERE "id" = $1`, i).Scan(&id); err != nil {
+			t.Errorf("Unexpected error. Error=%s", err)
+			return
+		}
+
+		rows, err := c.QueryContext(ctx, `SELECT "id" FROM "Person"`)
+		if err != nil {
+			t.Errorf("Unexpected error. Error=%s", err)
+			return
+		}
+		_ = rows.Close()
+	}
+
+	prepares := countPrepares(db.Queries())
+	test_utils.CompareInt(t, "CACHED STATEMENTS", c.Len(), 3)
+	test_utils.CompareInt(t, "PREPARE DELETE", prepares[`DELETE FROM "Person" WHERE "id" = $1`], 1)
+	test_utils.CompareInt(t, "PREPARE SELECT ROW", prepares[`SELECT "id" FROM "Person" WHERE "id" = $1`], 1)
+	test_utils.CompareInt(t, "PREPARE SELECT", prepares[`SELECT "id" FROM "Person"`], 1)
+}
+
+func TestStmtCache_Evict(t *testing.T) {
+	db := test_utils.OpenStubDB(func(q string, args []driver.Value) test_utils.StubResult {
+		return test_utils.StubResult{}
+	})
+	defer db.Close()
+
+	c := nsql.NewStmtCache(db.DB, 2)
+	defer c.Close()
+	ctx := context.Background()
+
+	exec := func(q string) {
+		if _, err := c.ExecContext(ctx, q); err != nil {
+			t.Errorf("Unexpected error. Error=%s", err)
+		}
+	}
+
+	// Execute queries, then q1 is evicted as the least recently used
+	exec("q1")
+	exec("q2")
+	exec("q2")
+	exec("q3")
+	test_utils.CompareInt(t, "LEN", c.Len(), 2)
+
+	// q2 is still cached, and q1 is prepared again
+	exec("q2")
+	exec("q1")
+
+	prepares := countPrepares(db.Queries())
+	test_utils.CompareInt(t, "PREPARE q1", prepares["q1"], 2)
+	test_utils.CompareInt(t, "PREPARE q2", prepares["q2"], 1)
+	test_utils.CompareInt(t, "PREPARE q3", prepares["q3"], 1)
+
+	// Close clear cache
+	if err := c.Close(); err != nil {
+		t.Errorf("Unexpected error. Error=%s", err)
+	}
+	test_utils.CompareInt(t, "LEN AFTER CLOSE", c.Len(), 0)
+}
+
+func TestStmtCache_Concurrent(t *testing.T) {
+	db := test_utils.OpenStubDB(func(q string, args []driver.Value) test_utils.StubResult {
+		return test_utils.StubResult{}
+	})
+	defer db.Close()
+
+	c := nsql.NewStmtCache(db.DB, 4)
+	defer c.Close()
+	ctx := context.Background()
+
+	// Execute more distinct queries than cache size, so statements are evicted while being used
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if _, err := c.ExecContext(ctx, fmt.Sprintf("q%d", i%8)); err != nil {
+				t.Errorf("Unexpected error. Error=%s", err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	test_utils.CompareBoolean(t, "LEN", c.Len() <= 4, true)
+}
+
+func BenchmarkStmtCache(b *testing.B) {
+	db := test_utils.OpenStubDB(func(q string, args []driver.Value) test_utils.StubResult {
+		return test_utils.StubResult{}
+	})
+	defer db.Close()
+
+	c := nsql.NewStmtCache(db.DB, 0)
+	defer c.Close()
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = c.ExecContext(ctx, `DELETE FROM "Person" WHERE "id" = $1`, i)
+	}
+}
@@ -0,0 +1,20 @@
+package nsql
+
+import "sync/atomic"
+
+var strictMode int32
+
+// SetStrictMode set default strict mode for all query builders. In strict mode, BuildE returns DroppedError instead of
+// silently dropping columns and conditions that are not declared in schema
+func SetStrictMode(enabled bool) {
+	var v int32
+	if enabled {
+		v = 1
+	}
+	atomic.StoreInt32(&strictMode, v)
+}
+
+// IsStrictMode returns default strict mode for all query builders
+func IsStrictMode() bool {
+	return atomic.LoadInt32(&strictMode) == 1
+}
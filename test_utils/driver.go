@@ -0,0 +1,209 @@
+package test_utils
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"sync"
+)
+
+// StubResult is a response returned by StubHandler for a query executed against stub database
+type StubResult struct {
+	Columns      []string
+	Rows         [][]driver.Value
+	LastInsertId int64
+	RowsAffected int64
+	Err          error
+}
+
+// StubHandler resolve result for a query. Transaction statements are passed as "BEGIN", "COMMIT" and "ROLLBACK"
+type StubHandler func(query string, args []driver.Value) StubResult
+
+// StubDB wraps sql.DB that is opened using a stub driver, and record executed queries
+type StubDB struct {
+	*sql.DB
+	mu      sync.Mutex
+	queries []string
+}
+
+// Queries returns executed queries in order
+func (db *StubDB) Queries() []string {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	return append([]string{}, db.queries...)
+}
+
+func (db *StubDB) record(q string) {
+	db.mu.Lock()
+	db.queries = append(db.queries, q)
+	db.mu.Unlock()
+}
+
+// OpenStubDB open a sql.DB that resolve every query with handler, so database/sql consumers can be tested without
+// a live database server
+func OpenStubDB(h StubHandler) *StubDB {
+	db := &StubDB{}
+	db.DB = sql.OpenDB(&stubConnector{handler: h, db: db})
+	return db
+}
+
+type stubConnector struct {
+	handler StubHandler
+	db      *StubDB
+}
+
+func (c *stubConnector) Connect(_ context.Context) (driver.Conn, error) {
+	return &stubConn{connector: c}, nil
+}
+
+func (c *stubConnector) Driver() driver.Driver {
+	return stubDriver{}
+}
+
+type stubDriver struct{}
+
+func (stubDriver) Open(_ string) (driver.Conn, error) {
+	return nil, errors.New("test_utils: stub driver must be opened with OpenStubDB")
+}
+
+type stubConn struct {
+	connector *stubConnector
+}
+
+func (c *stubConn) call(q string, args []driver.NamedValue) StubResult {
+	c.connector.db.record(q)
+	values := make([]driver.Value, len(args))
+	for i, a := range args {
+		values[i] = a.Value
+	}
+	return c.connector.handler(q, values)
+}
+
+// Prepare record query as "PREPARE <query>", so tests can assert that statement is prepared
+func (c *stubConn) Prepare(q string) (driver.Stmt, error) {
+	c.connector.db.record("PREPARE " + q)
+	return &stubStmt{conn: c, query: q}, nil
+}
+
+func (c *stubConn) Close() error {
+	return nil
+}
+
+func (c *stubConn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *stubConn) BeginTx(_ context.Context, _ driver.TxOptions) (driver.Tx, error) {
+	if r := c.call("BEGIN", nil); r.Err != nil {
+		return nil, r.Err
+	}
+	return &stubTx{conn: c}, nil
+}
+
+func (c *stubConn) QueryContext(_ context.Context, q string, args []driver.NamedValue) (driver.Rows, error) {
+	r := c.call(q, args)
+	if r.Err != nil {
+		return nil, r.Err
+	}
+	return &stubRows{columns: r.Columns, rows: r.Rows}, nil
+}
+
+func (c *stubConn) ExecContext(_ context.Context, q string, args []driver.NamedValue) (driver.Result, error) {
+	r := c.call(q, args)
+	if r.Err != nil {
+		return nil, r.Err
+	}
+	return &stubExecResult{lastInsertId: r.LastInsertId, rowsAffected: r.RowsAffected}, nil
+}
+
+// CheckNamedValue accepts any argument value, so custom types can be asserted by handler
+func (c *stubConn) CheckNamedValue(_ *driver.NamedValue) error {
+	return nil
+}
+
+type stubStmt struct {
+	conn  *stubConn
+	query string
+}
+
+func (s *stubStmt) Close() error {
+	return nil
+}
+
+func (s *stubStmt) NumInput() int {
+	return -1
+}
+
+func (s *stubStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return s.conn.ExecContext(context.Background(), s.query, toNamedValues(args))
+}
+
+func (s *stubStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return s.conn.QueryContext(context.Background(), s.query, toNamedValues(args))
+}
+
+func (s *stubStmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	return s.conn.ExecContext(ctx, s.query, args)
+}
+
+func (s *stubStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	return s.conn.QueryContext(ctx, s.query, args)
+}
+
+type stubTx struct {
+	conn *stubConn
+}
+
+func (t *stubTx) Commit() error {
+	return t.conn.call("COMMIT", nil).Err
+}
+
+func (t *stubTx) Rollback() error {
+	return t.conn.call("ROLLBACK", nil).Err
+}
+
+type stubRows struct {
+	columns []string
+	rows    [][]driver.Value
+	pos     int
+}
+
+func (r *stubRows) Columns() []string {
+	return r.columns
+}
+
+func (r *stubRows) Close() error {
+	return nil
+}
+
+func (r *stubRows) Next(dest []driver.Value) error {
+	if r.pos >= len(r.rows) {
+		return io.EOF
+	}
+	copy(dest, r.rows[r.pos])
+	r.pos += 1
+	return nil
+}
+
+type stubExecResult struct {
+	lastInsertId int64
+	rowsAffected int64
+}
+
+func (r *stubExecResult) LastInsertId() (int64, error) {
+	return r.lastInsertId, nil
+}
+
+func (r *stubExecResult) RowsAffected() (int64, error) {
+	return r.rowsAffected, nil
+}
+
+func toNamedValues(args []driver.Value) []driver.NamedValue {
+	result := make([]driver.NamedValue, len(args))
+	for i, v := range args {
+		result[i] = driver.NamedValue{Ordinal: i + 1, Value: v}
+	}
+	return result
+}
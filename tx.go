@@ -0,0 +1,183 @@
+package nsql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	mysqlErr "github.com/nbs-go/nsql/mysql/error"
+	"strconv"
+	"time"
+)
+
+// ErrTxPanic is returned by WithTx if transaction function panicked. Use errors.Is to check
+var ErrTxPanic = errors.New("nsql: transaction panicked")
+
+// TxBeginner is implemented by *sql.DB and *sql.Conn
+type TxBeginner interface {
+	BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error)
+}
+
+// TxFunc is a function that is executed in transaction. Pass ctx to nested WithTx call to create a savepoint
+type TxFunc func(ctx context.Context, tx *sql.Tx) error
+
+// TxOptions configure transaction and retry behaviour of WithTx
+type TxOptions struct {
+	// Isolation is isolation level of transaction. If zero, then driver default is used
+	Isolation sql.IsolationLevel
+	// ReadOnly set transaction to read only
+	ReadOnly bool
+	// MaxRetries is max count of retries on serialization failure or deadlock. If zero, then DefaultTxMaxRetries is
+	// used. Set to negative value to disable retry
+	MaxRetries int
+	// Backoff returns delay before retry attempt that is started from 1. If nil, then DefaultTxBackoff is used
+	Backoff func(attempt int) time.Duration
+	// Retryable returns true if error should be retried. If nil, then IsRetryableTxError is used
+	Retryable func(err error) bool
+}
+
+const DefaultTxMaxRetries = 3
+
+// DefaultTxBackoff returns exponential delay that is started from 10 milliseconds
+func DefaultTxBackoff(attempt int) time.Duration {
+	return time.Duration(1<<(attempt-1)) * 10 * time.Millisecond
+}
+
+// WithTx execute fn in transaction. Transaction is committed if fn returns nil, otherwise it will be rolled back.
+// Panic in fn is recovered, the transaction is rolled back and an error that wraps ErrTxPanic is returned.
+//
+// If transaction fails on serialization failure or deadlock, then the whole transaction is retried with backoff. So fn
+// must be safe to be executed more than once.
+//
+// If ctx is passed from an outer WithTx, then fn is executed in a savepoint of the outer transaction instead. Error in
+// fn only rolls back to the savepoint and is returned to outer fn. Nested call is not retried, db and opts are ignored
+func WithTx(ctx context.Context, db TxBeginner, opts *TxOptions, fn TxFunc) error {
+	// If ctx has transaction, then run in savepoint
+	if s, ok := ctx.Value(txContextKey{}).(*txState); ok {
+		return withSavepoint(ctx, s, fn)
+	}
+
+	if opts == nil {
+		opts = &TxOptions{}
+	}
+
+	maxRetries := opts.MaxRetries
+	if maxRetries == 0 {
+		maxRetries = DefaultTxMaxRetries
+	}
+
+	backoff := opts.Backoff
+	if backoff == nil {
+		backoff = DefaultTxBackoff
+	}
+
+	retryable := opts.Retryable
+	if retryable == nil {
+		retryable = IsRetryableTxError
+	}
+
+	txOpts := &sql.TxOptions{Isolation: opts.Isolation, ReadOnly: opts.ReadOnly}
+
+	for attempt := 0; ; attempt++ {
+		err := runTx(ctx, db, txOpts, fn)
+		if err == nil || attempt >= maxRetries || !retryable(err) {
+			return err
+		}
+
+		// Wait before retry
+		t := time.NewTimer(backoff(attempt + 1))
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return err
+		case <-t.C:
+		}
+	}
+}
+
+// TxFromContext returns transaction that is started by WithTx
+func TxFromContext(ctx context.Context) (*sql.Tx, bool) {
+	s, ok := ctx.Value(txContextKey{}).(*txState)
+	if !ok {
+		return nil, false
+	}
+	return s.tx, true
+}
+
+// IsRetryableTxError returns true if error is a serialization failure or deadlock from Postgres or MySQL
+func IsRetryableTxError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	switch code, _ := ParseError(err); code {
+	case SerializationFailureError, DeadlockError:
+		return true
+	}
+
+	// MySQL may resolve deadlock by waiting until lock timeout
+	if code, _ := mysqlErr.Parse(err); code == mysqlErr.LockTimeoutError {
+		return true
+	}
+
+	return false
+}
+
+type txContextKey struct{}
+
+type txState struct {
+	tx    *sql.Tx
+	depth int
+}
+
+func runTx(ctx context.Context, db TxBeginner, opts *sql.TxOptions, fn TxFunc) (err error) {
+	tx, err := db.BeginTx(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = panicError(r)
+		}
+
+		if err != nil {
+			_ = tx.Rollback()
+			return
+		}
+
+		err = tx.Commit()
+	}()
+
+	return fn(context.WithValue(ctx, txContextKey{}, &txState{tx: tx}), tx)
+}
+
+func withSavepoint(ctx context.Context, s *txState, fn TxFunc) (err error) {
+	name := "nsql_sp_" + strconv.Itoa(s.depth+1)
+
+	if _, err = s.tx.ExecContext(ctx, "SAVEPOINT "+name); err != nil {
+		return err
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = panicError(r)
+		}
+
+		if err != nil {
+			_, _ = s.tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name)
+			return
+		}
+
+		_, err = s.tx.ExecContext(ctx, "RELEASE SAVEPOINT "+name)
+	}()
+
+	return fn(context.WithValue(ctx, txContextKey{}, &txState{tx: s.tx, depth: s.depth + 1}), s.tx)
+}
+
+func panicError(r interface{}) error {
+	if e, ok := r.(error); ok {
+		return fmt.Errorf("%w: %s", ErrTxPanic, e)
+	}
+	return fmt.Errorf("%w: %v", ErrTxPanic, r)
+}
@@ -0,0 +1,150 @@
+package nsql_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"github.com/lib/pq"
+	"github.com/nbs-go/nsql"
+	"github.com/nbs-go/nsql/test_utils"
+	"testing"
+	"time"
+)
+
+type mysqlError struct {
+	Number  uint16
+	Message string
+}
+
+func (e *mysqlError) Error() string {
+	return "Error 1213 (40001): " + e.Message
+}
+
+var noBackoff = &nsql.TxOptions{
+	Backoff: func(int) time.Duration { return 0 },
+}
+
+func TestWithTx_Commit(t *testing.T) {
+	db := test_utils.OpenStubDB(func(q string, args []driver.Value) test_utils.StubResult {
+		return test_utils.StubResult{RowsAffected: 1}
+	})
+	defer db.Close()
+
+	err := nsql.WithTx(context.Background(), db.DB, nil, func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `DELETE FROM "Customer"`)
+		return err
+	})
+	if err != nil {
+		t.Errorf("Unexpected error. Error=%s", err)
+		return
+	}
+
+	test_utils.CompareStringArray(t, "COMMIT", db.Queries(), []string{"BEGIN", `DELETE FROM "Customer"`, "COMMIT"})
+}
+
+func TestWithTx_Rollback(t *testing.T) {
+	db := test_utils.OpenStubDB(func(q string, args []driver.Value) test_utils.StubResult {
+		return test_utils.StubResult{}
+	})
+	defer db.Close()
+
+	// Test #1
+	expErr := errors.New("failed")
+	err := nsql.WithTx(context.Background(), db.DB, nil, func(ctx context.Context, tx *sql.Tx) error {
+		return expErr
+	})
+	test_utils.CompareBoolean(t, "ROLLBACK ERROR", err == expErr, true)
+	test_utils.CompareStringArray(t, "ROLLBACK", db.Queries(), []string{"BEGIN", "ROLLBACK"})
+
+	// Test #2
+	err = nsql.WithTx(context.Background(), db.DB, nil, func(ctx context.Context, tx *sql.Tx) error {
+		panic("unexpected")
+	})
+	test_utils.CompareBoolean(t, "RECOVER PANIC", errors.Is(err, nsql.ErrTxPanic), true)
+	test_utils.CompareStringArray(t, "RECOVER PANIC ROLLBACK", db.Queries(),
+		[]string{"BEGIN", "ROLLBACK", "BEGIN", "ROLLBACK"})
+}
+
+func TestWithTx_Retry(t *testing.T) {
+	commits := 0
+	db := test_utils.OpenStubDB(func(q string, args []driver.Value) test_utils.StubResult {
+		if q == "COMMIT" {
+			commits++
+			if commits == 1 {
+				return test_utils.StubResult{Err: &pq.Error{Code: "40001"}}
+			}
+		}
+		return test_utils.StubResult{}
+	})
+	defer db.Close()
+
+	// Test #1
+	calls := 0
+	err := nsql.WithTx(context.Background(), db.DB, noBackoff, func(ctx context.Context, tx *sql.Tx) error {
+		calls++
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Unexpected error. Error=%s", err)
+		return
+	}
+	test_utils.CompareInt(t, "RETRY SERIALIZATION FAILURE", calls, 2)
+
+	// Test #2
+	calls = 0
+	err = nsql.WithTx(context.Background(), db.DB, noBackoff, func(ctx context.Context, tx *sql.Tx) error {
+		calls++
+		return &mysqlError{Number: 1213, Message: "Deadlock found when trying to get lock"}
+	})
+	test_utils.CompareBoolean(t, "RETRY EXHAUSTED ERROR", err != nil, true)
+	test_utils.CompareInt(t, "RETRY EXHAUSTED", calls, nsql.DefaultTxMaxRetries+1)
+
+	// Test #3
+	calls = 0
+	err = nsql.WithTx(context.Background(), db.DB, nil, func(ctx context.Context, tx *sql.Tx) error {
+		calls++
+		return &pq.Error{Code: "23505"}
+	})
+	test_utils.CompareBoolean(t, "NOT RETRYABLE ERROR", err != nil, true)
+	test_utils.CompareInt(t, "NOT RETRYABLE", calls, 1)
+}
+
+func TestWithTx_Savepoint(t *testing.T) {
+	db := test_utils.OpenStubDB(func(q string, args []driver.Value) test_utils.StubResult {
+		return test_utils.StubResult{}
+	})
+	defer db.Close()
+
+	expErr := errors.New("failed")
+	err := nsql.WithTx(context.Background(), db.DB, nil, func(ctx context.Context, tx *sql.Tx) error {
+		// Test context transaction
+		ctxTx, ok := nsql.TxFromContext(ctx)
+		test_utils.CompareBoolean(t, "TX FROM CONTEXT", ok && ctxTx == tx, true)
+
+		// Release savepoint
+		if err := nsql.WithTx(ctx, db.DB, nil, func(ctx context.Context, tx *sql.Tx) error {
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		// Rollback to savepoint
+		err := nsql.WithTx(ctx, db.DB, nil, func(ctx context.Context, tx *sql.Tx) error {
+			return expErr
+		})
+		test_utils.CompareBoolean(t, "SAVEPOINT ERROR", err == expErr, true)
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Unexpected error. Error=%s", err)
+		return
+	}
+
+	test_utils.CompareStringArray(t, "SAVEPOINT", db.Queries(), []string{
+		"BEGIN",
+		"SAVEPOINT nsql_sp_1", "RELEASE SAVEPOINT nsql_sp_1",
+		"SAVEPOINT nsql_sp_1", "ROLLBACK TO SAVEPOINT nsql_sp_1",
+		"COMMIT",
+	})
+}
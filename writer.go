@@ -3,6 +3,7 @@ package nsql
 import (
 	"github.com/nbs-go/nsql/op"
 	"github.com/nbs-go/nsql/schema"
+	"strings"
 )
 
 // SchemaReference must be implemented by part of query that may not require defining schema,
@@ -33,6 +34,8 @@ type ColumnGetter interface {
 // SelectWriter must be implemented by part of query that will generate query in SELECT
 type SelectWriter interface {
 	SelectQuery() string
+	// WriteSelectQuery append query to sb, so query parts are written without allocating intermediate strings
+	WriteSelectQuery(sb *strings.Builder)
 	SetFormat(format op.ColumnFormat)
 	IsAllColumns() bool
 	AliasSetter
@@ -42,16 +45,25 @@ type SelectWriter interface {
 // FromWriter must be implemented by part of query that will generate query in FROM
 type FromWriter interface {
 	FromQuery() string
+	WriteFromQuery(sb *strings.Builder)
 	Join(j JoinWriter)
 	SchemaRefGetter
 }
 
 type WhereWriter interface {
 	WhereQuery() string
+	WriteWhereQuery(sb *strings.Builder)
+}
+
+// ArgsGetter must be implemented by part of query that carries values of its bind variables. Values are returned in
+// the same order of written placeholders
+type ArgsGetter interface {
+	GetArgs() []interface{}
 }
 
 type OrderByWriter interface {
 	OrderByQuery() string
+	WriteOrderByQuery(sb *strings.Builder)
 	AliasSetter
 	SchemaReference
 }
@@ -71,6 +83,7 @@ type WhereLogicWriter interface {
 
 type ColumnWriter interface {
 	ColumnQuery() string
+	WriteColumnQuery(sb *strings.Builder)
 	SetFormat(format op.ColumnFormat)
 	ColumnGetter
 	AliasSetter
@@ -83,6 +96,7 @@ type Expander interface {
 
 type JoinWriter interface {
 	JoinQuery() string
+	WriteJoinQuery(sb *strings.Builder)
 	GetTableName() string
 	GetIndex() int
 	SetIndex(s int)
@@ -91,4 +105,5 @@ type JoinWriter interface {
 
 type VariableWriter interface {
 	VariableQuery() string
+	WriteVariableQuery(sb *strings.Builder)
 }